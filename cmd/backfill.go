@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/date"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/runhistory"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/fatih/color"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/urfave/cli/v3"
+)
+
+const backfillDateFormat = "2006-01-02 15:04:05.000000"
+
+// backfillFlagsWithValues are the `bruin run` flags that are rewritten for every interval and take a value.
+var backfillFlagsWithValues = []string{"start-date", "end-date", "backfill-concurrency"}
+
+// backfillBoolFlags are the `bruin run` flags that are dropped from the interval runs.
+var backfillBoolFlags = []string{"backfill"}
+
+type backfillIntervalResult struct {
+	Interval pipeline.Interval
+	Duration time.Duration
+	Err      error
+}
+
+// determineBackfillStartDate picks the start of the backfill range: the CLI start date, unless it was not given
+// explicitly and the pipeline enables catchup from its own start_date.
+func determineBackfillStartDate(cliStartDate time.Time, startDateSet bool, p *pipeline.Pipeline) (time.Time, error) {
+	if !backfillCatchesUp(startDateSet, p) {
+		return cliStartDate, nil
+	}
+
+	startDate, err := date.ParseTime(p.StartDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid pipeline start_date '%s': %w", p.StartDate, err)
+	}
+
+	return startDate, nil
+}
+
+// backfillCatchesUp tells whether the backfill range starts from the pipeline's start_date because of catchup.
+func backfillCatchesUp(startDateSet bool, p *pipeline.Pipeline) bool {
+	return !startDateSet && p.Catchup && p.StartDate != ""
+}
+
+// missedIntervals drops the intervals that a previous run of the pipeline already completed successfully, it is how
+// `catchup_mode: active` only catches up on the intervals that were missed. The runs are matched by their dates, so
+// both the runs started by a backfill and the ones started with the same dates by hand count.
+func missedIntervals(p *pipeline.Pipeline, intervals []pipeline.Interval, store *runhistory.Store) ([]pipeline.Interval, error) {
+	runs, err := store.List(runhistory.Filter{Pipeline: p.Name, Status: runhistory.StatusSucceeded})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the run history")
+	}
+
+	completed := make(map[pipeline.Interval]bool, len(runs))
+	for _, run := range runs {
+		start, startErr := date.ParseTime(run.Parameters.StartDate)
+		end, endErr := date.ParseTime(run.Parameters.EndDate)
+		if startErr != nil || endErr != nil {
+			continue
+		}
+		completed[pipeline.Interval{Start: start.UTC(), End: end.UTC()}] = true
+	}
+
+	missed := make([]pipeline.Interval, 0, len(intervals))
+	for _, interval := range intervals {
+		if !completed[pipeline.Interval{Start: interval.Start.UTC(), End: interval.End.UTC()}] {
+			missed = append(missed, interval)
+		}
+	}
+
+	return missed, nil
+}
+
+// buildBackfillArgs rewrites the arguments of the current `bruin run` invocation so that it runs a single interval.
+func buildBackfillArgs(args []string, commandName string, interval pipeline.Interval) []string {
+	filtered := make([]string, 0, len(args)+4)
+	for i := 0; i < len(args); i++ {
+		name, hasValue, isFlag := splitFlag(args[i])
+		if !isFlag {
+			filtered = append(filtered, args[i])
+			continue
+		}
+
+		switch {
+		case slices.Contains(backfillBoolFlags, name):
+			continue
+		case slices.Contains(backfillFlagsWithValues, name):
+			if !hasValue {
+				i++
+			}
+			continue
+		}
+
+		filtered = append(filtered, args[i])
+	}
+
+	dateArgs := []string{
+		"--start-date", interval.Start.Format(backfillDateFormat),
+		"--end-date", interval.End.Format(backfillDateFormat),
+	}
+
+	for i, arg := range filtered {
+		if arg == commandName {
+			result := make([]string, 0, len(filtered)+len(dateArgs))
+			result = append(result, filtered[:i+1]...)
+			result = append(result, dateArgs...)
+			return append(result, filtered[i+1:]...)
+		}
+	}
+
+	return append(dateArgs, filtered...)
+}
+
+func splitFlag(arg string) (string, bool, bool) {
+	if !strings.HasPrefix(arg, "-") || arg == "-" || arg == "--" {
+		return "", false, false
+	}
+
+	name := strings.TrimLeft(arg, "-")
+	if idx := strings.Index(name, "="); idx >= 0 {
+		return name[:idx], true, true
+	}
+
+	return name, false, true
+}
+
+// runBackfill runs the given intervals as separate `bruin run` invocations, with at most `concurrency` of them at once.
+// The dates are rendered into the assets and baked into the connections, the run state and the run history when the
+// pipeline is loaded, a fresh process per interval gets all of them right without threading the interval through the
+// whole run command.
+func runBackfill(ctx context.Context, p *pipeline.Pipeline, intervals []pipeline.Interval, commandName string, concurrency int) error {
+	if len(intervals) == 0 {
+		warningPrinter.Println("No complete schedule intervals found in the given date range, nothing to backfill.")
+		return nil
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return errors.Wrap(err, "failed to find the bruin executable")
+	}
+
+	infoPrinter.Printf("Backfilling the pipeline '%s' over %d interval(s) with schedule '%s'.\n", p.Name, len(intervals), p.Schedule)
+	infoPrinter.Printf("Range: %s - %s\n\n", intervals[0].Start.Format(time.RFC3339), intervals[len(intervals)-1].End.Format(time.RFC3339))
+
+	results := make([]*backfillIntervalResult, len(intervals))
+	semaphore := make(chan struct{}, concurrency)
+	var outputLock sync.Mutex
+	var wg sync.WaitGroup
+
+	for i, interval := range intervals {
+		if ctx.Err() != nil {
+			results[i] = &backfillIntervalResult{Interval: interval, Err: ctx.Err()}
+			continue
+		}
+
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(i int, interval pipeline.Interval) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			label := formatBackfillInterval(interval)
+			var stdout, stderr io.Writer = os.Stdout, os.Stderr
+			if concurrency > 1 {
+				prefix := faint(fmt.Sprintf("[%s] ", interval.Start.Format(backfillDateFormat)))
+				stdout = &prefixWriter{w: os.Stdout, prefix: prefix, lock: &outputLock}
+				stderr = &prefixWriter{w: os.Stderr, prefix: prefix, lock: &outputLock}
+			}
+
+			outputLock.Lock()
+			infoPrinter.Printf("[%d/%d] Running interval %s\n", i+1, len(intervals), label)
+			outputLock.Unlock()
+
+			args := buildBackfillArgs(os.Args[1:], commandName, interval)
+			command := exec.CommandContext(ctx, executable, args...) //nolint:gosec
+			command.Stdout = stdout
+			command.Stderr = stderr
+			command.Stdin = os.Stdin
+
+			start := time.Now()
+			runErr := command.Run()
+			results[i] = &backfillIntervalResult{Interval: interval, Duration: time.Since(start), Err: runErr}
+
+			if flusher, ok := stdout.(*prefixWriter); ok {
+				flusher.Flush()
+			}
+			if flusher, ok := stderr.(*prefixWriter); ok {
+				flusher.Flush()
+			}
+
+			outputLock.Lock()
+			if runErr != nil {
+				errorPrinter.Printf("[%d/%d] Interval %s failed: %v\n\n", i+1, len(intervals), label, runErr)
+			} else {
+				successPrinter.Printf("[%d/%d] Interval %s succeeded\n\n", i+1, len(intervals), label)
+			}
+			outputLock.Unlock()
+		}(i, interval)
+	}
+
+	wg.Wait()
+
+	return printBackfillSummary(results)
+}
+
+func printBackfillSummary(results []*backfillIntervalResult) error {
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	summaryPrinter.Printf("\nBackfill summary: %d interval(s), %s, %s\n\n",
+		len(results),
+		color.New(color.FgGreen).Sprintf("%d succeeded", len(results)-failed),
+		color.New(color.FgRed).Sprintf("%d failed", failed))
+
+	for _, result := range results {
+		label := formatBackfillInterval(result.Interval)
+		switch {
+		case errors.Is(result.Err, context.Canceled) || errors.Is(result.Err, context.DeadlineExceeded):
+			summaryPrinter.Printf(" %s %s %s\n", color.New(color.Faint).Sprint("-"), label, faint("(not started)"))
+		case result.Err != nil:
+			summaryPrinter.Printf(" %s %s %s\n", color.New(color.FgRed).Sprint("✗"), label, faint(fmt.Sprintf("(%s)", result.Duration.Truncate(time.Millisecond))))
+		default:
+			summaryPrinter.Printf(" %s %s %s\n", color.New(color.FgGreen).Sprint("✓"), label, faint(fmt.Sprintf("(%s)", result.Duration.Truncate(time.Millisecond))))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d backfill intervals failed", failed, len(results))
+	}
+
+	return nil
+}
+
+func formatBackfillInterval(interval pipeline.Interval) string {
+	return fmt.Sprintf("%s - %s", interval.Start.Format(time.RFC3339), interval.End.Format(time.RFC3339))
+}
+
+// prefixWriter prefixes every line written to it, so that the output of parallel intervals can be told apart.
+type prefixWriter struct {
+	w      io.Writer
+	prefix string
+	lock   *sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	p.buffer.Write(b)
+	for {
+		line, err := p.buffer.ReadBytes('\n')
+		if err != nil {
+			// keep the incomplete line around until the rest of it arrives
+			p.buffer.Reset()
+			p.buffer.Write(line)
+			break
+		}
+
+		if writeErr := p.writeLine(line); writeErr != nil {
+			return 0, writeErr
+		}
+	}
+
+	return len(b), nil
+}
+
+// Flush writes out any incomplete line that is left in the buffer.
+func (p *prefixWriter) Flush() {
+	if p.buffer.Len() == 0 {
+		return
+	}
+
+	line := append(p.buffer.Bytes(), '\n')
+	p.buffer.Reset()
+	_ = p.writeLine(line)
+}
+
+func (p *prefixWriter) writeLine(line []byte) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	_, err := p.w.Write(append([]byte(p.prefix), line...))
+	return err
+}
+
+func runBackfillCommand(ctx context.Context, c *cli.Command, p *pipeline.Pipeline, runConfig *scheduler.RunConfig, repoRoot string, startDate, endDate time.Time) error {
+	switch {
+	case c.Bool("continue"):
+		errorPrinter.Println("Cannot use --backfill together with --continue, rerun the failed intervals with their own dates instead.")
+		return cli.Exit("", 1)
+	case runConfig.FullRefresh:
+		errorPrinter.Println("Cannot use --backfill together with --full-refresh, every interval would truncate the tables again.")
+		return cli.Exit("", 1)
+	case runConfig.Output == "json", c.String("junit-report") != "":
+		errorPrinter.Println("Cannot use --backfill together with --output json or --junit-report, every interval is a separate run with its own results.")
+		return cli.Exit("", 1)
+	}
+
+	startDate, err := determineBackfillStartDate(startDate, c.IsSet("start-date"), p)
+	if err != nil {
+		errorPrinter.Println(err.Error())
+		return cli.Exit("", 1)
+	}
+
+	intervals, err := p.Schedule.Intervals(startDate, endDate)
+	if err != nil {
+		errorPrinter.Printf("Failed to compute the backfill intervals for pipeline '%s': %v\n", p.Name, err)
+		return cli.Exit("", 1)
+	}
+
+	// an explicit --start-date asks for those intervals to run again, catchup_mode only applies to the catchup range
+	if backfillCatchesUp(c.IsSet("start-date"), p) && p.CatchupMode == "active" {
+		total := len(intervals)
+		intervals, err = missedIntervals(p, intervals, runhistory.NewStore(afero.NewOsFs(), runHistoryPath(repoRoot)))
+		if err != nil {
+			errorPrinter.Println(err.Error())
+			return cli.Exit("", 1)
+		}
+		if skipped := total - len(intervals); skipped > 0 {
+			infoPrinter.Printf("Skipping %d interval(s) that already succeeded, catchup_mode is 'active'.\n", skipped)
+		}
+	}
+
+	exeCtx, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if err := runBackfill(exeCtx, p, intervals, c.Name, c.Int("backfill-concurrency")); err != nil {
+		errorPrinter.Println(err.Error())
+		return cli.Exit("", 1)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/runhistory"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBackfillArgs(t *testing.T) {
+	t.Parallel()
+
+	interval := pipeline.Interval{
+		Start: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 1, 2, 23, 59, 59, 999999000, time.UTC),
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "dates and backfill flags are replaced",
+			args: []string{"run", "--backfill", "--start-date", "2024-01-01", "--end-date=2024-01-31", "--workers", "4", "pipelines/sales"},
+			want: []string{"run", "--start-date", "2024-01-02 00:00:00.000000", "--end-date", "2024-01-02 23:59:59.999999", "--workers", "4", "pipelines/sales"},
+		},
+		{
+			name: "global flags before the command are kept in place",
+			args: []string{"--debug", "run", "-backfill", "--backfill-concurrency", "3", "--env", "prod", "pipelines/sales"},
+			want: []string{"--debug", "run", "--start-date", "2024-01-02 00:00:00.000000", "--end-date", "2024-01-02 23:59:59.999999", "--env", "prod", "pipelines/sales"},
+		},
+		{
+			name: "backfill flag with an explicit value",
+			args: []string{"run", "--backfill=true", "--backfill-concurrency=2", "pipelines/sales"},
+			want: []string{"run", "--start-date", "2024-01-02 00:00:00.000000", "--end-date", "2024-01-02 23:59:59.999999", "pipelines/sales"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, buildBackfillArgs(tt.args, "run", interval))
+		})
+	}
+}
+
+func TestDetermineBackfillStartDate(t *testing.T) {
+	t.Parallel()
+
+	cliStart := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := determineBackfillStartDate(cliStart, false, &pipeline.Pipeline{Catchup: true, StartDate: "2024-01-01"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), got)
+
+	got, err = determineBackfillStartDate(cliStart, true, &pipeline.Pipeline{Catchup: true, StartDate: "2024-01-01"})
+	require.NoError(t, err)
+	assert.Equal(t, cliStart, got)
+
+	got, err = determineBackfillStartDate(cliStart, false, &pipeline.Pipeline{StartDate: "2024-01-01"})
+	require.NoError(t, err)
+	assert.Equal(t, cliStart, got)
+
+	_, err = determineBackfillStartDate(cliStart, false, &pipeline.Pipeline{Catchup: true, StartDate: "yesterday"})
+	require.Error(t, err)
+}
+
+func TestMissedIntervals(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) pipeline.Interval {
+		start := time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+		return pipeline.Interval{Start: start, End: start.Add(24*time.Hour - time.Microsecond)}
+	}
+	record := func(store *runhistory.Store, pipelineName, status, startDate, endDate string) {
+		require.NoError(t, store.Record(&runhistory.Run{
+			Pipeline:   pipelineName,
+			Status:     status,
+			Parameters: scheduler.RunConfig{StartDate: startDate, EndDate: endDate},
+		}))
+	}
+
+	store := runhistory.NewStore(afero.NewMemMapFs(), "/repo/logs/runs/history.jsonl")
+	record(store, "sales", runhistory.StatusSucceeded, "2024-01-01 00:00:00.000000", "2024-01-01 23:59:59.999999")
+	record(store, "sales", runhistory.StatusFailed, "2024-01-02 00:00:00.000000", "2024-01-02 23:59:59.999999")
+	record(store, "marketing", runhistory.StatusSucceeded, "2024-01-03 00:00:00.000000", "2024-01-03 23:59:59.999999")
+	// a run started by hand with the same dates counts as well
+	record(store, "sales", runhistory.StatusSucceeded, "2024-01-04", "2024-01-04 23:59:59.999999")
+	// a run covering a different range does not complete any of the intervals
+	record(store, "sales", runhistory.StatusSucceeded, "2024-01-05", "2024-01-06 23:59:59.999999")
+
+	intervals := []pipeline.Interval{day(1), day(2), day(3), day(4), day(5)}
+	got, err := missedIntervals(&pipeline.Pipeline{Name: "sales"}, intervals, store)
+	require.NoError(t, err)
+	assert.Equal(t, []pipeline.Interval{day(2), day(3), day(5)}, got)
+}
+
+func TestPrefixWriter(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	w := &prefixWriter{w: &out, prefix: "[a] ", lock: &sync.Mutex{}}
+
+	_, err := w.Write([]byte("first line\nsecond "))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("line\nunterminated"))
+	require.NoError(t, err)
+	w.Flush()
+
+	assert.Equal(t, "[a] first line\n[a] second line\n[a] unterminated\n", out.String())
+}
@@ -118,6 +118,9 @@ func variableOverridesMutator(variables []string) pipeline.PipelineMutator {
 				overrides[key] = value
 			}
 		}
+		if err := p.Variables.ValidateValues(overrides); err != nil {
+			return nil, fmt.Errorf("invalid variable overrides: %w", err)
+		}
 		err := p.Variables.Merge(overrides)
 		if err != nil {
 			return nil, fmt.Errorf("invalid variable overrides: %w", err)
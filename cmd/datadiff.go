@@ -156,6 +156,10 @@ func DataDiffCmd() *cli.Command {
 	var reverse bool
 	var outputFormat string
 	var dryRun bool
+	var rows bool
+	var keyColumns []string
+	var assetPath string
+	var sampleSize int
 
 	return &cli.Command{
 		Name:    "data-diff",
@@ -214,6 +218,27 @@ func DataDiffCmd() *cli.Command {
 				Usage:       "Estimate the cost of the comparison without executing it (outputs JSON). Only supported for BigQuery connections.",
 				Destination: &dryRun,
 			},
+			&cli.BoolFlag{
+				Name:        "rows",
+				Usage:       "Compare the tables row by row using their key columns and print sample added, removed and changed rows",
+				Destination: &rows,
+			},
+			&cli.StringSliceFlag{
+				Name:        "key",
+				Usage:       "Key columns used to match the rows of both tables in the row-level comparison, defaults to the primary key columns of the asset given via --asset",
+				Destination: &keyColumns,
+			},
+			&cli.StringFlag{
+				Name:        "asset",
+				Usage:       "Path to the asset whose primary key columns are used as the key of the row-level comparison",
+				Destination: &assetPath,
+			},
+			&cli.IntFlag{
+				Name:        "sample-size",
+				Usage:       "Number of sample rows to print for each kind of difference in the row-level comparison",
+				Destination: &sampleSize,
+				Value:       10,
+			},
 		},
 		Action: func(ctx context.Context, c *cli.Command) error {
 			if c.NArg() != 2 {
@@ -343,6 +368,24 @@ func DataDiffCmd() *cli.Command {
 			}
 
 			if schemaComparison != nil {
+				var rowDiff *diff.RowDiffResult
+				if rows {
+					rowDiff, err = diffTableRows(ctx, conn1, conn2, table1Name, table2Name, *schemaComparison, keyColumns, assetPath, sampleSize)
+					if err != nil {
+						if outputFormat == "json" {
+							jsonErr := map[string]string{"error": fmt.Sprintf("error comparing the rows of tables '%s' and '%s': %v", table1Identifier, table2Identifier, err)}
+							jsonBytes, marshalErr := json.Marshal(jsonErr)
+							if marshalErr != nil {
+								return fmt.Errorf("failed to marshal JSON error output: %w", marshalErr)
+							}
+							fmt.Fprintln(c.Writer, string(jsonBytes))
+						} else {
+							errorPrinter.Printf("error comparing the rows of tables '%s' and '%s':\n\n%v", table1Identifier, table2Identifier, err)
+						}
+						return cli.Exit("", 1)
+					}
+				}
+
 				// Generate ALTER TABLE statements
 				var alterStatements []string
 				if schemaComparison.HasSchemaDifferences {
@@ -352,6 +395,10 @@ func DataDiffCmd() *cli.Command {
 				// Handle JSON output
 				if outputFormat == "json" {
 					jsonOutput := buildJSONOutput(*schemaComparison, table1Identifier, table2Identifier, alterStatements, tolerance)
+					if rowDiff != nil {
+						jsonOutput.RowDiff = rowDiff
+						jsonOutput.HasDifferences = jsonOutput.HasDifferences || rowDiff.HasDifferences()
+					}
 					jsonBytes, err := json.Marshal(jsonOutput)
 					if err != nil {
 						return fmt.Errorf("failed to marshal JSON output: %w", err)
@@ -366,6 +413,10 @@ func DataDiffCmd() *cli.Command {
 
 				// Plain text output (original behavior)
 				hasDifferences := printSchemaComparisonOutput(*schemaComparison, table1Identifier, table2Identifier, tolerance, !full, c.ErrWriter)
+				if rowDiff != nil {
+					printRowDiffOutput(rowDiff, table1Identifier, table2Identifier, c.ErrWriter)
+					hasDifferences = hasDifferences || rowDiff.HasDifferences()
+				}
 
 				// Print ALTER TABLE statements
 				if len(alterStatements) > 0 {
@@ -397,6 +448,191 @@ func DataDiffCmd() *cli.Command {
 	}
 }
 
+// diffTableRows runs the row-level comparison of two tables, explicit key columns take precedence over the primary
+// key columns of the given asset.
+func diffTableRows(ctx context.Context, conn1, conn2 any, table1Name, table2Name string, schemaComparison diff.SchemaComparisonResult, keys []string, assetPath string, sampleSize int) (*diff.RowDiffResult, error) {
+	q1, ok := conn1.(diff.RowQuerier)
+	if !ok {
+		return nil, fmt.Errorf("connection type %T does not support row-level comparison", conn1)
+	}
+	q2, ok := conn2.(diff.RowQuerier)
+	if !ok {
+		return nil, fmt.Errorf("connection type %T does not support row-level comparison", conn2)
+	}
+
+	if len(keys) == 0 && assetPath != "" {
+		asset, err := DefaultPipelineBuilder.CreateAssetFromFile(assetPath, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the asset '%s': %w", assetPath, err)
+		}
+		if asset == nil {
+			return nil, fmt.Errorf("the given file path doesn't seem to be a Bruin asset definition: '%s'", assetPath)
+		}
+		keys = asset.ColumnNamesWithPrimaryKey()
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("asset '%s' does not have any primary key columns, please provide the key columns via --key", asset.Name)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("row-level comparison requires key columns, please provide them via --key or point to an asset with primary key columns via --asset")
+	}
+
+	keyColumns, columns, err := buildRowDiffColumns(schemaComparison, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff.DiffRows(
+		ctx,
+		diff.RowDiffTable{Name: table1Name, Querier: q1, Dialect: diff.DialectForConnection(conn1)},
+		diff.RowDiffTable{Name: table2Name, Querier: q2, Dialect: diff.DialectForConnection(conn2)},
+		diff.RowDiffOptions{
+			KeyColumns: keyColumns,
+			Columns:    columns,
+			SampleSize: sampleSize,
+		},
+	)
+}
+
+// buildRowDiffColumns pairs the columns that exist in both tables, column names are matched case-insensitively since
+// platforms like Snowflake return them in upper case.
+func buildRowDiffColumns(schemaComparison diff.SchemaComparisonResult, keys []string) ([]diff.RowDiffColumn, []diff.RowDiffColumn, error) {
+	t1Schema := schemaComparison.Table1.Table
+	t2Schema := schemaComparison.Table2.Table
+
+	t1Columns := make(map[string]*diff.Column, len(t1Schema.Columns))
+	for _, col := range t1Schema.Columns {
+		t1Columns[strings.ToLower(col.Name)] = col
+	}
+	t2Columns := make(map[string]*diff.Column, len(t2Schema.Columns))
+	for _, col := range t2Schema.Columns {
+		t2Columns[strings.ToLower(col.Name)] = col
+	}
+
+	pair := func(t1Col, t2Col *diff.Column) diff.RowDiffColumn {
+		columnType := t1Col.NormalizedType
+		if t2Col.NormalizedType == diff.CommonTypeNumeric {
+			columnType = diff.CommonTypeNumeric
+		}
+
+		return diff.RowDiffColumn{Table1Name: t1Col.Name, Table2Name: t2Col.Name, Type: columnType}
+	}
+
+	keyColumns := make([]diff.RowDiffColumn, 0, len(keys))
+	isKey := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		name := strings.ToLower(strings.TrimSpace(key))
+		t1Col, ok := t1Columns[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("key column '%s' does not exist in table '%s'", key, t1Schema.Name)
+		}
+		t2Col, ok := t2Columns[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("key column '%s' does not exist in table '%s'", key, t2Schema.Name)
+		}
+
+		keyColumns = append(keyColumns, pair(t1Col, t2Col))
+		isKey[name] = true
+	}
+
+	columns := make([]diff.RowDiffColumn, 0, len(t1Schema.Columns))
+	for _, t1Col := range t1Schema.Columns {
+		name := strings.ToLower(t1Col.Name)
+		if isKey[name] {
+			continue
+		}
+		if t2Col, ok := t2Columns[name]; ok {
+			columns = append(columns, pair(t1Col, t2Col))
+		}
+	}
+
+	return keyColumns, columns, nil
+}
+
+func printRowDiffOutput(result *diff.RowDiffResult, table1Name, table2Name string, errOut io.Writer) {
+	fmt.Fprintf(errOut, "\n\nRow-level comparison (key: %s):\n", strings.Join(result.KeyColumns, ", "))
+
+	summary := table.NewWriter()
+	summary.SetStyle(table.StyleRounded)
+	summary.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, Align: text.AlignLeft},
+		{Number: 2, Align: text.AlignRight},
+	})
+	summary.AppendHeader(table.Row{"", "ROWS"})
+	summary.AppendRow(table.Row{"Only in " + table1Name + " (removed)", result.RemovedCount})
+	summary.AppendRow(table.Row{"Only in " + table2Name + " (added)", result.AddedCount})
+	summary.AppendRow(table.Row{"Changed", result.ChangedCount})
+	fmt.Fprintf(errOut, "%s\n", summary.Render())
+
+	if !result.HasDifferences() {
+		color.New(color.FgGreen).Fprintf(errOut, "\n%s\n", "All rows are identical.")
+		return
+	}
+
+	if len(result.Removed) > 0 {
+		fmt.Fprintf(errOut, "\nSample rows only in %s:\n%s\n", table1Name, rowSamplesToTable(result, result.Removed))
+	}
+	if len(result.Added) > 0 {
+		fmt.Fprintf(errOut, "\nSample rows only in %s:\n%s\n", table2Name, rowSamplesToTable(result, result.Added))
+	}
+	if len(result.Changed) > 0 {
+		fmt.Fprintf(errOut, "\nSample changed rows:\n%s\n", changedRowsToTable(result, table1Name, table2Name))
+	}
+}
+
+func rowSamplesToTable(result *diff.RowDiffResult, samples []diff.RowSample) string {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+
+	header := make(table.Row, 0, len(result.KeyColumns)+len(result.Columns))
+	for _, col := range result.KeyColumns {
+		header = append(header, col)
+	}
+	for _, col := range result.Columns {
+		header = append(header, col)
+	}
+	t.AppendHeader(header)
+
+	for _, sample := range samples {
+		row := make(table.Row, 0, len(sample.Key)+len(sample.Values))
+		for _, v := range sample.Key {
+			row = append(row, v)
+		}
+		for _, v := range sample.Values {
+			row = append(row, v)
+		}
+		t.AppendRow(row)
+	}
+
+	return t.Render()
+}
+
+func changedRowsToTable(result *diff.RowDiffResult, table1Name, table2Name string) string {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+
+	header := make(table.Row, 0, len(result.KeyColumns)+3)
+	for _, col := range result.KeyColumns {
+		header = append(header, col)
+	}
+	header = append(header, "COLUMN", table1Name, table2Name)
+	t.AppendHeader(header)
+
+	for _, changed := range result.Changed {
+		for _, difference := range changed.Differences {
+			row := make(table.Row, 0, len(changed.Key)+3)
+			for _, v := range changed.Key {
+				row = append(row, v)
+			}
+			row = append(row, difference.Column, difference.SourceValue, difference.TargetValue)
+			t.AppendRow(row)
+		}
+	}
+
+	return t.Render()
+}
+
 func printSchemaComparisonOutput(schemaComparison diff.SchemaComparisonResult, table1Name, table2Name string, tolerance float64, schemaOnly bool, errOut io.Writer) bool {
 	fmt.Fprint(errOut, schemaComparison.GetSummaryTable()+"\n")
 
@@ -1040,13 +1276,14 @@ func getColumnValue(col *diff.Column, exists bool, property string) string {
 
 // JSON output types for --output json flag.
 type JSONDiffOutput struct {
-	Summary          JSONSummary       `json:"summary"`
-	SchemaDiffs      []JSONColumnDiff  `json:"schemaDiffs"`
-	ColumnStatistics []JSONColumnStats `json:"columnStatistics"`
-	SourceTable      string            `json:"sourceTable"`
-	TargetTable      string            `json:"targetTable"`
-	HasDifferences   bool              `json:"hasDifferences"`
-	AlterStatements  string            `json:"alterStatements"`
+	Summary          JSONSummary         `json:"summary"`
+	SchemaDiffs      []JSONColumnDiff    `json:"schemaDiffs"`
+	ColumnStatistics []JSONColumnStats   `json:"columnStatistics"`
+	SourceTable      string              `json:"sourceTable"`
+	TargetTable      string              `json:"targetTable"`
+	HasDifferences   bool                `json:"hasDifferences"`
+	AlterStatements  string              `json:"alterStatements"`
+	RowDiff          *diff.RowDiffResult `json:"rowDiff,omitempty"`
 }
 
 type JSONSummary struct {
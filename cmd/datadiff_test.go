@@ -634,3 +634,90 @@ func TestEstimateDiffCost(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to estimate cost for table")
 	})
 }
+
+func TestBuildRowDiffColumns(t *testing.T) {
+	t.Parallel()
+
+	schemaComparison := diff.SchemaComparisonResult{
+		Table1: &diff.TableSummaryResult{
+			Table: &diff.Table{
+				Name: "public.orders",
+				Columns: []*diff.Column{
+					{Name: "id", NormalizedType: diff.CommonTypeNumeric},
+					{Name: "status", NormalizedType: diff.CommonTypeString},
+					{Name: "amount", NormalizedType: diff.CommonTypeString},
+					{Name: "legacy_flag", NormalizedType: diff.CommonTypeBoolean},
+				},
+			},
+		},
+		Table2: &diff.TableSummaryResult{
+			Table: &diff.Table{
+				Name: "ANALYTICS.ORDERS",
+				Columns: []*diff.Column{
+					{Name: "ID", NormalizedType: diff.CommonTypeNumeric},
+					{Name: "AMOUNT", NormalizedType: diff.CommonTypeNumeric},
+					{Name: "STATUS", NormalizedType: diff.CommonTypeString},
+				},
+			},
+		},
+	}
+
+	t.Run("columns are matched case-insensitively", func(t *testing.T) {
+		t.Parallel()
+
+		keyColumns, columns, err := buildRowDiffColumns(schemaComparison, []string{"ID"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []diff.RowDiffColumn{
+			{Table1Name: "id", Table2Name: "ID", Type: diff.CommonTypeNumeric},
+		}, keyColumns)
+		assert.Equal(t, []diff.RowDiffColumn{
+			{Table1Name: "status", Table2Name: "STATUS", Type: diff.CommonTypeString},
+			{Table1Name: "amount", Table2Name: "AMOUNT", Type: diff.CommonTypeNumeric},
+		}, columns)
+	})
+
+	t.Run("key column missing from one of the tables", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := buildRowDiffColumns(schemaComparison, []string{"legacy_flag"})
+		require.Error(t, err)
+		assert.Equal(t, "key column 'legacy_flag' does not exist in table 'ANALYTICS.ORDERS'", err.Error())
+	})
+}
+
+func TestDiffTableRows_RequiresKeys(t *testing.T) {
+	t.Parallel()
+
+	_, err := diffTableRows(t.Context(), &mockCostEstimator{}, &mockCostEstimator{}, "a", "b", diff.SchemaComparisonResult{}, nil, "", 10)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not support row-level comparison")
+}
+
+func TestPrintRowDiffOutput(t *testing.T) {
+	t.Parallel()
+
+	result := &diff.RowDiffResult{
+		KeyColumns:   []string{"id"},
+		Columns:      []string{"status"},
+		AddedCount:   1,
+		RemovedCount: 0,
+		ChangedCount: 1,
+		Added:        []diff.RowSample{{Key: []string{"3"}, Values: []string{"new"}}},
+		Removed:      []diff.RowSample{},
+		Changed: []diff.ChangedRow{
+			{Key: []string{"1"}, Differences: []diff.ColumnValueDifference{{Column: "status", SourceValue: "paid", TargetValue: "refunded"}}},
+		},
+	}
+
+	var out strings.Builder
+	printRowDiffOutput(result, "pg:orders", "sf:orders", &out)
+	output := out.String()
+
+	assert.Contains(t, output, "Row-level comparison (key: id)")
+	assert.Contains(t, output, "Only in sf:orders (added)")
+	assert.Contains(t, output, "Sample rows only in sf:orders")
+	assert.NotContains(t, output, "Sample rows only in pg:orders")
+	assert.Contains(t, output, "Sample changed rows")
+	assert.Contains(t, output, "refunded")
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/ansisql"
+	"github.com/bruin-data/bruin/pkg/git"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/fatih/color"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/xlab/treeprint"
+)
+
+const failingRowsFolder = "failing_rows"
+
+var failingRowsFileNameReplacer = strings.NewReplacer(":", "__", "/", "_", "\\", "_", " ", "_")
+
+// failingRowsOf returns the sample of the rows that violated the check of the result, if it was fetched.
+func failingRowsOf(err error) *ansisql.FailingRows {
+	var checkErr *ansisql.CheckError
+	if !errors.As(err, &checkErr) {
+		return nil
+	}
+
+	return checkErr.FailingRows
+}
+
+// saveFailingRows writes the failing rows sampled for the failed and warned checks of the run as CSV files under the
+// logs folder, one file per check, and records the path of the file in the sample.
+func saveFailingRows(fs afero.Fs, repoRoot, runID string, results []*scheduler.TaskExecutionResult) error {
+	dir := filepath.Join(repoRoot, LogsFolder, failingRowsFolder, runID)
+
+	saved := false
+	for _, result := range results {
+		sample := failingRowsOf(result.Error)
+		if sample == nil {
+			sample = failingRowsOf(checkWarning(result.Instance))
+		}
+		if sample == nil || sample.Error != "" || len(sample.Rows) == 0 {
+			continue
+		}
+
+		if err := fs.MkdirAll(dir, 0o755); err != nil {
+			return errors.Wrap(err, "failed to create the failing rows folder")
+		}
+
+		path := filepath.Join(dir, failingRowsFileNameReplacer.Replace(result.Instance.GetHumanID())+".csv")
+		if err := writeFailingRowsCSV(fs, path, sample); err != nil {
+			return err
+		}
+
+		sample.Path = path
+		saved = true
+	}
+
+	if !saved {
+		return nil
+	}
+
+	// the rows come straight from the tables, they must never end up in the repository
+	return git.EnsureGivenPatternIsInGitignore(fs, repoRoot, LogsFolder+"/"+failingRowsFolder)
+}
+
+func writeFailingRowsCSV(fs afero.Fs, path string, sample *ansisql.FailingRows) error {
+	f, err := fs.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create the failing rows file '%s'", path)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if len(sample.Columns) > 0 {
+		if err := w.Write(sample.Columns); err != nil {
+			return errors.Wrapf(err, "failed to write the failing rows file '%s'", path)
+		}
+	}
+
+	for _, row := range sample.Rows {
+		if err := w.Write(formatFailingRow(row)); err != nil {
+			return errors.Wrapf(err, "failed to write the failing rows file '%s'", path)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return errors.Wrapf(err, "failed to write the failing rows file '%s'", path)
+	}
+
+	return nil
+}
+
+func formatFailingRow(row []interface{}) []string {
+	values := make([]string, len(row))
+	for i, cell := range row {
+		if cell == nil {
+			values[i] = "NULL"
+			continue
+		}
+		values[i] = fmt.Sprintf("%v", formatQueryCellForDisplay(cell))
+	}
+
+	return values
+}
+
+// addFailingRowsNodes lists the failing rows of the failed check under its node in the error summary.
+func addFailingRowsNodes(node treeprint.Tree, err error) {
+	sample := failingRowsOf(err)
+	if sample == nil {
+		return
+	}
+
+	if sample.Error != "" {
+		node.AddNode(faint("failed to fetch the failing rows: " + sample.Error))
+		return
+	}
+
+	branch := node.AddBranch(faint(failingRowsTitle(sample)))
+	for _, row := range sample.Rows {
+		branch.AddNode(describeFailingRow(sample.Columns, row))
+	}
+}
+
+// printFailingRows prints the failing rows of a single check run, where there is no summary tree to attach them to.
+func printFailingRows(sample *ansisql.FailingRows) {
+	if sample.Error != "" {
+		fmt.Printf("Failed to fetch the failing rows: %s\n", sample.Error)
+		return
+	}
+
+	fmt.Printf("Failing rows (%s):\n", failingRowsTitle(sample))
+	for _, row := range sample.Rows {
+		fmt.Printf("  %s\n", describeFailingRow(sample.Columns, row))
+	}
+	fmt.Println()
+}
+
+func failingRowsTitle(sample *ansisql.FailingRows) string {
+	title := fmt.Sprintf("%d failing rows", len(sample.Rows))
+	if sample.Path != "" {
+		title += ", saved to " + sample.Path
+	}
+
+	return title
+}
+
+func describeFailingRow(columns []string, row []interface{}) string {
+	values := formatFailingRow(row)
+	if len(columns) != len(values) {
+		return strings.Join(values, ", ")
+	}
+
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = color.New(color.FgCyan).Sprint(columns[i]) + "=" + v
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/ansisql"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xlab/treeprint"
+)
+
+func TestSaveFailingRows(t *testing.T) {
+	t.Parallel()
+
+	asset := &pipeline.Asset{Name: "orders"}
+	checkInstance := func(humanID string) *scheduler.ColumnCheckInstance {
+		return &scheduler.ColumnCheckInstance{AssetInstance: &scheduler.AssetInstance{HumanID: humanID, Asset: asset}}
+	}
+
+	sampled := &ansisql.FailingRows{
+		Columns: []string{"id", "status"},
+		Rows:    [][]interface{}{{int64(1), nil}, {int64(2), "a,b"}},
+	}
+	failedToSample := &ansisql.FailingRows{Error: "permission denied"}
+
+	results := []*scheduler.TaskExecutionResult{
+		{Instance: checkInstance("orders:status:not_null"), Error: &ansisql.CheckError{Message: "2 nulls", FailingRows: sampled}},
+		{Instance: checkInstance("orders:id:unique"), Error: &ansisql.CheckError{Message: "1 duplicate", FailingRows: failedToSample}},
+		{Instance: checkInstance("orders:id:positive"), Error: errors.New("connection refused")},
+	}
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, saveFailingRows(fs, "/repo", "run-1", results))
+
+	assert.Equal(t, "/repo/logs/failing_rows/run-1/orders__status__not_null.csv", sampled.Path)
+	content, err := afero.ReadFile(fs, sampled.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "id,status\n1,NULL\n2,\"a,b\"\n", string(content))
+
+	assert.Empty(t, failedToSample.Path)
+	files, err := afero.ReadDir(fs, "/repo/logs/failing_rows/run-1")
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	gitignore, err := afero.ReadFile(fs, "/repo/.gitignore")
+	require.NoError(t, err)
+	assert.Contains(t, string(gitignore), "logs/failing_rows")
+}
+
+func TestSaveFailingRows_NothingSampled(t *testing.T) {
+	t.Parallel()
+
+	results := []*scheduler.TaskExecutionResult{
+		{
+			Instance: &scheduler.ColumnCheckInstance{AssetInstance: &scheduler.AssetInstance{HumanID: "orders:id:not_null", Asset: &pipeline.Asset{Name: "orders"}}},
+			Error:    &ansisql.CheckError{Message: "2 nulls"},
+		},
+	}
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, saveFailingRows(fs, "/repo", "run-1", results))
+
+	exists, err := afero.Exists(fs, "/repo/logs/failing_rows")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = afero.Exists(fs, "/repo/.gitignore")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestAddFailingRowsNodes(t *testing.T) {
+	t.Parallel()
+
+	tree := treeprint.NewWithRoot("orders")
+	addFailingRowsNodes(tree, &ansisql.CheckError{
+		Message: "2 nulls",
+		FailingRows: &ansisql.FailingRows{
+			Columns: []string{"id", "status"},
+			Rows:    [][]interface{}{{int64(1), nil}},
+			Path:    "logs/failing_rows/run-1/orders__status__not_null.csv",
+		},
+	})
+	addFailingRowsNodes(tree, errors.New("not a check error"))
+
+	assert.Equal(t, `orders
+└── 1 failing rows, saved to logs/failing_rows/run-1/orders__status__not_null.csv
+    └── id=1, status=NULL
+`, tree.String())
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 
+	lineagepackage "github.com/bruin-data/bruin/pkg/lineage"
 	"github.com/bruin-data/bruin/pkg/path"
 	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/sqlparser"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v3"
 )
@@ -14,8 +18,8 @@ import (
 func Lineage() *cli.Command {
 	return &cli.Command{
 		Name:      "lineage",
-		Usage:     "dump the lineage for a given asset",
-		ArgsUsage: "[path to the asset definition]",
+		Usage:     "dump the lineage for a given asset, or render the lineage graph of a pipeline",
+		ArgsUsage: "[path to the asset definition or the pipeline]",
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
 				Name:  "full",
@@ -30,12 +34,36 @@ func Lineage() *cli.Command {
 				Name:  "variant",
 				Usage: "variant name to materialize for variant pipelines",
 			},
+			&cli.BoolFlag{
+				Name:  "columns",
+				Usage: "trace the lineage of the columns instead of the assets",
+			},
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "render the lineage graph instead of the summary, possible values are: dot, mermaid, html",
+			},
+			&cli.StringFlag{
+				Name:  "selector",
+				Usage: "select the assets of the graph using the selector syntax of 'bruin run', requires --format and a pipeline path",
+			},
 		},
 		Action: func(ctx context.Context, c *cli.Command) error {
 			r := LineageCommand{
-				builder:      DefaultPipelineBuilder,
-				infoPrinter:  infoPrinter,
-				errorPrinter: errorPrinter,
+				builder:       DefaultPipelineBuilder,
+				infoPrinter:   infoPrinter,
+				errorPrinter:  errorPrinter,
+				columnLineage: extractColumnLineage,
+			}
+
+			if format := c.String("format"); format != "" {
+				return r.RunGraph(ctx, c.Args().Get(0), format, c.String("selector"), c.Bool("full"), c.Bool("columns"), c.String("variant"))
+			}
+			if c.String("selector") != "" {
+				errorPrinter.Printf("The --selector flag can only be used together with --format.\n")
+				return cli.Exit("", 1)
+			}
+			if c.Bool("columns") {
+				return r.RunColumns(ctx, c.Args().Get(0), c.Bool("full"), c.String("output"), c.String("variant"))
 			}
 
 			return r.Run(ctx, c.Args().Get(0), c.Bool("full"), c.String("output"), c.String("variant"))
@@ -53,6 +81,18 @@ type LineageCommand struct {
 	builder      taskCreator
 	infoPrinter  printer
 	errorPrinter printer
+
+	// columnLineage fills the column upstreams of the assets of the pipeline, it is only needed for --columns.
+	columnLineage func(p *pipeline.Pipeline) error
+	out           io.Writer
+}
+
+func (r *LineageCommand) writer() io.Writer {
+	if r.out == nil {
+		return os.Stdout
+	}
+
+	return r.out
 }
 
 func (r *LineageCommand) Run(ctx context.Context, assetPath string, fullLineage bool, output, variantName string) error {
@@ -200,3 +240,241 @@ func (r *LineageCommand) printLineageSummary(p *pipeline.Pipeline, assets []*pip
 		r.infoPrinter.Printf("\nTotal: %d\n", len(assets)+len(*additional))
 	}
 }
+
+// RunColumns prints the upstream and downstream columns of each column of the asset across the pipeline.
+func (r *LineageCommand) RunColumns(ctx context.Context, assetPath string, fullLineage bool, output, variantName string) error {
+	if assetPath == "" {
+		r.errorPrinter.Printf("Please give an asset path to get the column lineage of: bruin lineage --columns <path to the asset definition>\n")
+		return cli.Exit("", 1)
+	}
+
+	pipelinePath, err := path.GetPipelineRootFromTask(assetPath, PipelineDefinitionFiles)
+	if err != nil {
+		r.errorPrinter.Printf("Failed to find the pipeline this task belongs to: '%s'\n", assetPath)
+		return cli.Exit("", 1)
+	}
+
+	foundPipeline, err := r.createPipeline(ctx, pipelinePath, output, variantName)
+	if err != nil {
+		return err
+	}
+
+	asset := foundPipeline.GetAssetByPath(assetPath)
+	if asset == nil {
+		r.errorPrinter.Println("failed to find the asset with the given path, are you sure you have referred the right file?")
+		return cli.Exit("", 1)
+	}
+
+	if err := r.columnLineage(foundPipeline); err != nil {
+		printError(err, output, "Failed to extract the column lineage")
+		return cli.Exit("", 1)
+	}
+
+	graph := lineagepackage.NewColumnGraph(foundPipeline, foundPipeline.Assets)
+
+	type columnSummary struct {
+		Name       string                      `json:"name"`
+		Upstream   []*lineagepackage.GraphNode `json:"upstreams"`
+		Downstream []*lineagepackage.GraphNode `json:"downstream"`
+	}
+
+	columns := make([]*columnSummary, 0, len(asset.Columns))
+	for _, column := range asset.Columns {
+		node := graph.ColumnNode(asset.Name, column.Name)
+		columns = append(columns, &columnSummary{
+			Name:       column.Name,
+			Upstream:   graph.Upstream(node, fullLineage),
+			Downstream: graph.Downstream(node, fullLineage),
+		})
+	}
+
+	if output == "json" {
+		jsonVersion, err := json.Marshal(struct {
+			AssetName string             `json:"name"`
+			Type      pipeline.AssetType `json:"type"`
+			Columns   []*columnSummary   `json:"columns"`
+		}{
+			AssetName: asset.Name,
+			Type:      asset.Type,
+			Columns:   columns,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal the column lineage to json")
+		}
+
+		fmt.Fprintln(r.writer(), string(jsonVersion))
+		return nil
+	}
+
+	r.infoPrinter.Printf("\nColumn Lineage: '%s'\n", asset.Name)
+	if len(columns) == 0 {
+		r.infoPrinter.Println("\nAsset has no columns, define them or use a query the columns can be extracted from.")
+		return nil
+	}
+
+	for _, column := range columns {
+		r.infoPrinter.Print("\n")
+		r.infoPrinter.Println(column.Name)
+		r.infoPrinter.Println("========================")
+		r.printColumnNodes("Upstream:", column.Upstream, "Column has no upstream columns.")
+		r.printColumnNodes("Downstream:", column.Downstream, "Column has no downstream columns.")
+	}
+
+	return nil
+}
+
+func (r *LineageCommand) printColumnNodes(title string, nodes []*lineagepackage.GraphNode, absenceMessage string) {
+	r.infoPrinter.Println(title)
+	if len(nodes) == 0 {
+		r.infoPrinter.Printf("  %s\n", absenceMessage)
+		return
+	}
+
+	for _, node := range nodes {
+		if node.External {
+			r.infoPrinter.Printf("- %s.%s %s\n", node.Asset, node.Column, faint("(EXTERNAL)"))
+			continue
+		}
+		r.infoPrinter.Printf("- %s.%s\n", node.Asset, node.Column)
+	}
+}
+
+// RunGraph renders the lineage graph of the whole pipeline, of the assets matching the selector, or of an asset
+// together with its dependencies.
+func (r *LineageCommand) RunGraph(ctx context.Context, inputPath, format, selector string, fullLineage, columns bool, variantName string) error {
+	if format != "dot" && format != "mermaid" && format != "html" {
+		r.errorPrinter.Printf("Invalid format '%s', possible values are: dot, mermaid, html\n", format)
+		return cli.Exit("", 1)
+	}
+	if inputPath == "" {
+		r.errorPrinter.Printf("Please give an asset or a pipeline path to render the lineage of: bruin lineage --format %s <path to the asset or the pipeline>\n", format)
+		return cli.Exit("", 1)
+	}
+
+	pipelinePath := inputPath
+	runningForAnAsset := isPathReferencingAsset(inputPath)
+	if runningForAnAsset {
+		if selector != "" {
+			r.errorPrinter.Printf("Cannot use --selector with an asset path, give the path of the pipeline instead.\n")
+			return cli.Exit("", 1)
+		}
+
+		var err error
+		pipelinePath, err = path.GetPipelineRootFromTask(inputPath, PipelineDefinitionFiles)
+		if err != nil {
+			r.errorPrinter.Printf("Failed to find the pipeline this task belongs to: '%s'\n", inputPath)
+			return cli.Exit("", 1)
+		}
+	}
+
+	foundPipeline, err := r.createPipeline(ctx, pipelinePath, "plain", variantName)
+	if err != nil {
+		return err
+	}
+
+	title := foundPipeline.Name
+	assets := foundPipeline.Assets
+	switch {
+	case selector != "":
+		assets, err = pipeline.ResolveSelectorAssets(selector, foundPipeline)
+		if err != nil {
+			r.errorPrinter.Printf("Failed to resolve selector: %v\n", err)
+			return cli.Exit("", 1)
+		}
+	case runningForAnAsset:
+		asset := foundPipeline.GetAssetByPath(inputPath)
+		if asset == nil {
+			r.errorPrinter.Println("failed to find the asset with the given path, are you sure you have referred the right file?")
+			return cli.Exit("", 1)
+		}
+
+		title = asset.Name
+		assets = assetWithDependencies(asset, fullLineage)
+	}
+
+	var graph *lineagepackage.Graph
+	if columns {
+		if err := r.columnLineage(foundPipeline); err != nil {
+			printError(err, "plain", "Failed to extract the column lineage")
+			return cli.Exit("", 1)
+		}
+		graph = lineagepackage.NewColumnGraph(foundPipeline, assets)
+	} else {
+		graph = lineagepackage.NewAssetGraph(assets)
+	}
+
+	switch format {
+	case "dot":
+		fmt.Fprint(r.writer(), graph.DOT())
+	case "mermaid":
+		fmt.Fprint(r.writer(), graph.Mermaid())
+	case "html":
+		fmt.Fprint(r.writer(), graph.HTML("Lineage: "+title))
+	}
+
+	return nil
+}
+
+func (r *LineageCommand) createPipeline(ctx context.Context, pipelinePath, output, variantName string) (*pipeline.Pipeline, error) {
+	opts := []pipeline.CreatePipelineOption{}
+	if variantName != "" {
+		opts = append(opts, pipeline.WithVariant(variantName))
+	}
+
+	foundPipeline, err := DefaultPipelineBuilder.CreatePipelineFromPath(ctx, pipelinePath, opts...)
+	if err != nil {
+		printError(err, output, "Failed to build pipeline")
+		return nil, cli.Exit("", 1)
+	}
+
+	return foundPipeline, nil
+}
+
+// assetWithDependencies returns the asset with its upstream and downstream assets, the direct ones unless full is set.
+func assetWithDependencies(asset *pipeline.Asset, fullLineage bool) []*pipeline.Asset {
+	upstream := asset.GetUpstream()
+	downstream := asset.GetDownstream()
+	if fullLineage {
+		upstream = asset.GetFullUpstream()
+		downstream = asset.GetFullDownstream()
+	}
+
+	candidates := make([]*pipeline.Asset, 0, len(upstream)+len(downstream)+1)
+	candidates = append(candidates, upstream...)
+	candidates = append(candidates, asset)
+	candidates = append(candidates, downstream...)
+
+	seen := make(map[*pipeline.Asset]bool, len(candidates))
+	assets := make([]*pipeline.Asset, 0, len(candidates))
+	for _, a := range candidates {
+		if seen[a] {
+			continue
+		}
+		seen[a] = true
+		assets = append(assets, a)
+	}
+
+	return assets
+}
+
+// extractColumnLineage parses the queries of the pipeline to fill the column upstreams of its assets.
+func extractColumnLineage(p *pipeline.Pipeline) error {
+	parser, err := sqlparser.NewSQLParser(false)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize the SQL parser")
+	}
+	defer parser.Close()
+
+	if err := parser.Start(); err != nil {
+		return errors.Wrap(err, "failed to start the SQL parser")
+	}
+
+	extractor := lineagepackage.NewLineageExtractor(parser)
+	processedAssets := make(map[string]bool)
+	for _, asset := range p.Assets {
+		// the assets whose queries cannot be parsed keep the columns they define, the rest of the pipeline is still traced
+		extractor.ColumnLineage(p, asset, processedAssets)
+	}
+
+	return nil
+}
@@ -147,3 +147,184 @@ Asset has no downstream dependencies.
 		})
 	}
 }
+
+func TestLineageCommand_RunGraph(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		inputPath string
+		format    string
+		selector  string
+		full      bool
+		columns   bool
+		want      string
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "invalid format",
+			inputPath: path.AbsPathForTests(t, "./testdata/simple-pipeline"),
+			format:    "svg",
+			wantErr:   assert.Error,
+		},
+		{
+			name:      "selector with an asset path",
+			inputPath: path.AbsPathForTests(t, "./testdata/simple-pipeline/assets/hello_bq.sql"),
+			format:    "dot",
+			selector:  "+nested1",
+			wantErr:   assert.Error,
+		},
+		{
+			name:      "whole pipeline",
+			inputPath: path.AbsPathForTests(t, "./testdata/lineage"),
+			format:    "mermaid",
+			want: `flowchart LR
+  n0["dashboard.hello_bq"]
+  n1["hello_python"]
+  n2["bigquery://project_id/dataset_id/table_id"]:::external
+  n1 --> n0
+  n2 --> n0
+  classDef external stroke-dasharray: 5 5
+`,
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "selected assets",
+			inputPath: path.AbsPathForTests(t, "./testdata/simple-pipeline"),
+			format:    "dot",
+			selector:  "+nested1",
+			want: `digraph lineage {
+  rankdir=LR;
+  node [shape=box];
+  n0 [label="dashboard.hello_bq"];
+  n1 [label="hello_python"];
+  n2 [label="nested1"];
+  n1 -> n0;
+  n0 -> n2;
+}
+`,
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "direct dependencies of an asset",
+			inputPath: path.AbsPathForTests(t, "./testdata/simple-pipeline/assets/hello_bq.sql"),
+			format:    "mermaid",
+			want: `flowchart LR
+  n0["hello_python"]
+  n1["dashboard.hello_bq"]
+  n2["nested1"]
+  n0 --> n1
+  n1 --> n2
+`,
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "columns of an asset",
+			inputPath: path.AbsPathForTests(t, "./testdata/simple-pipeline/assets/hello_bq.sql"),
+			format:    "mermaid",
+			columns:   true,
+			want: `flowchart LR
+  subgraph g0["dashboard.hello_bq"]
+    n0["one"]
+  end
+  subgraph g1["nested1"]
+    n1["one"]
+  end
+  n0 --> n1
+`,
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			out := bytes.NewBuffer(nil)
+			mp := &mockPrinter{buf: buf}
+
+			r := &LineageCommand{
+				infoPrinter:   mp,
+				errorPrinter:  mp,
+				columnLineage: fakeColumnLineage,
+				out:           out,
+			}
+
+			res := r.RunGraph(t.Context(), tt.inputPath, tt.format, tt.selector, tt.full, tt.columns, "")
+			tt.wantErr(t, res)
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+// fakeColumnLineage links the column of nested1 to the column of dashboard.hello_bq, the way the SQL parser would.
+func fakeColumnLineage(p *pipeline.Pipeline) error {
+	nested := p.GetAssetByName("nested1")
+	nested.Columns = []pipeline.Column{
+		{Name: "one", Upstreams: []*pipeline.UpstreamColumn{{Table: "dashboard.hello_bq", Column: "one"}}},
+	}
+
+	return nil
+}
+
+func TestLineageCommand_RunColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		assetPath string
+		output    string
+		want      string
+		wantOut   string
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "asset path is empty",
+			wantErr: assert.Error,
+		},
+		{
+			name:      "plain output",
+			assetPath: path.AbsPathForTests(t, "./testdata/simple-pipeline/assets/hello_bq.sql"),
+			output:    "plain",
+			want: `
+Column Lineage: 'dashboard.hello_bq'
+
+one
+========================
+Upstream:
+  Column has no upstream columns.
+Downstream:
+- nested1.one
+`,
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "json output",
+			assetPath: path.AbsPathForTests(t, "./testdata/simple-pipeline/assets/nested1.sql"),
+			output:    "json",
+			wantOut:   `{"name":"nested1","type":"bq.sql","columns":[{"name":"one","upstreams":[{"asset":"dashboard.hello_bq","column":"one","type":"bq.sql"}],"downstream":[]}]}` + "\n",
+			wantErr:   assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			out := bytes.NewBuffer(nil)
+			mp := &mockPrinter{buf: buf}
+
+			r := &LineageCommand{
+				infoPrinter:   mp,
+				errorPrinter:  mp,
+				columnLineage: fakeColumnLineage,
+				out:           out,
+			}
+
+			res := r.RunColumns(t.Context(), tt.assetPath, false, tt.output, "")
+			tt.wantErr(t, res)
+			if tt.want != "" {
+				assert.Equal(t, tt.want, buf.String())
+			}
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
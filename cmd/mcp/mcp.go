@@ -49,6 +49,10 @@ func MCPCmd() *cli.Command {
 				Usage: "Enable debug logging for MCP server",
 				Value: false,
 			},
+			&cli.StringSliceFlag{
+				Name:  "allowed-query-connections",
+				Usage: "connections the read-only bruin_query tool is allowed to run queries on, the tool is disabled if none are given",
+			},
 		},
 		Action: func(ctx context.Context, c *cli.Command) error {
 			debug := c.Bool("debug")
@@ -56,12 +60,12 @@ func MCPCmd() *cli.Command {
 			if debug {
 				fmt.Fprintf(os.Stderr, "Starting Bruin MCP server...\n")
 			}
-			return runMCPServer(debug)
+			return runMCPServer(ctx, debug, newProjectTools(c.StringSlice("allowed-query-connections")))
 		},
 	}
 }
 
-func runMCPServer(debug bool) error {
+func runMCPServer(ctx context.Context, debug bool, tools *projectTools) error {
 	scanner := bufio.NewScanner(os.Stdin)
 
 	telemetry.SendEvent("mcp_server_start", analytics.Properties{
@@ -87,7 +91,7 @@ func runMCPServer(debug bool) error {
 		if debug {
 			fmt.Fprintf(os.Stderr, "Processing method: %s\n", rpcRequest.Method)
 		}
-		response := processRequest(rpcRequest, debug)
+		response := processRequest(ctx, rpcRequest, debug, tools)
 
 		if response.JSONRPC != "" && response.ID != nil {
 			responseJSON, err := json.Marshal(response)
@@ -113,7 +117,7 @@ func runMCPServer(debug bool) error {
 	return nil
 }
 
-func processRequest(req JSONRPCRequest, debug bool) JSONRPCResponse {
+func processRequest(ctx context.Context, req JSONRPCRequest, debug bool, tools *projectTools) JSONRPCResponse {
 	switch req.Method {
 	// this is the mcp handshake
 	case "initialize":
@@ -145,7 +149,7 @@ func processRequest(req JSONRPCRequest, debug bool) JSONRPCResponse {
 			JSONRPC: "2.0",
 			ID:      req.ID,
 			Result: map[string]interface{}{
-				"tools": []map[string]interface{}{
+				"tools": append([]map[string]interface{}{
 					{
 						"name":        "bruin_get_overview",
 						"description": "Get information about Bruin's features and capabilities",
@@ -176,11 +180,11 @@ func processRequest(req JSONRPCRequest, debug bool) JSONRPCResponse {
 							"required": []string{"filename"},
 						},
 					},
-				},
+				}, tools.definitions()...),
 			},
 		}
 	case "tools/call":
-		return handleToolCall(req, debug)
+		return handleToolCall(ctx, req, debug, tools)
 
 	default:
 		return JSONRPCResponse{
@@ -194,8 +198,7 @@ func processRequest(req JSONRPCRequest, debug bool) JSONRPCResponse {
 	}
 }
 
-//nolint:unparam
-func handleToolCall(req JSONRPCRequest, debug bool) JSONRPCResponse {
+func handleToolCall(ctx context.Context, req JSONRPCRequest, debug bool, tools *projectTools) JSONRPCResponse {
 	if debug {
 		fmt.Fprintf(os.Stderr, "Handling tool call request\n")
 	}
@@ -302,6 +305,10 @@ func handleToolCall(req JSONRPCRequest, debug bool) JSONRPCResponse {
 		}
 
 	default:
+		if tools.handles(toolName) {
+			return handleProjectToolCall(ctx, req, params, toolName, debug, tools)
+		}
+
 		return JSONRPCResponse{
 			JSONRPC: "2.0",
 			ID:      req.ID,
@@ -313,6 +320,53 @@ func handleToolCall(req JSONRPCRequest, debug bool) JSONRPCResponse {
 	}
 }
 
+// handleProjectToolCall runs one of the project tools. Failures of the tool itself, such as an invalid asset or a
+// rejected query, are returned as a tool result with isError set so that the agent can see the reason and react.
+func handleProjectToolCall(ctx context.Context, req JSONRPCRequest, params map[string]interface{}, toolName string, debug bool, tools *projectTools) JSONRPCResponse {
+	args, _ := params["arguments"].(map[string]interface{})
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+
+	telemetry.SendEvent("mcp_tool_call", analytics.Properties{
+		"tool_name": toolName,
+	})
+
+	text, err := tools.call(ctx, toolName, args)
+	if err != nil {
+		if debug {
+			fmt.Fprintf(os.Stderr, "Tool %s failed: %v\n", toolName, err)
+		}
+
+		return JSONRPCResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Result: map[string]interface{}{
+				"content": []map[string]interface{}{
+					{
+						"type": "text",
+						"text": "Error: " + err.Error(),
+					},
+				},
+				"isError": true,
+			},
+		}
+	}
+
+	return JSONRPCResponse{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result: map[string]interface{}{
+			"content": []map[string]interface{}{
+				{
+					"type": "text",
+					"text": text,
+				},
+			},
+		},
+	}
+}
+
 func getBruinInfo() string {
 	content, err := docs.DocsFS.ReadFile("overview.md")
 	if err != nil {
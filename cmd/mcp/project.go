@@ -0,0 +1,384 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/helpers"
+	"github.com/bruin-data/bruin/pkg/path"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/spf13/afero"
+)
+
+var pipelineDefinitionFiles = []string{"pipeline.yml", "pipeline.yaml"}
+
+// commandRunner executes the bruin binary with the given arguments and returns what it printed to stdout.
+type commandRunner func(ctx context.Context, args ...string) ([]byte, error)
+
+// projectTools are the MCP tools that operate on the user's Bruin project. They call the bruin executable in a
+// subprocess so that the CLI output never mixes with the JSON-RPC messages written to stdout. The paths given by the
+// client are passed after "--", a path starting with a dash must not be parsed as a flag of the subprocess.
+type projectTools struct {
+	allowedQueryConnections []string
+	run                     commandRunner
+}
+
+func newProjectTools(allowedQueryConnections []string) *projectTools {
+	return &projectTools{
+		allowedQueryConnections: allowedQueryConnections,
+		run:                     runBruinExecutable,
+	}
+}
+
+func runBruinExecutable(ctx context.Context, args ...string) ([]byte, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the bruin executable: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, executable, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// the tool call itself is already tracked by the server, the subprocess should not report it a second time
+	cmd.Env = append(os.Environ(), "TELEMETRY_OPTOUT=1")
+
+	if err := cmd.Run(); err != nil {
+		output := strings.TrimSpace(stdout.String() + "\n" + stderr.String())
+		if output == "" {
+			return nil, fmt.Errorf("bruin %s failed: %w", args[0], err)
+		}
+		return nil, fmt.Errorf("bruin %s failed: %s", args[0], output)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func (t *projectTools) definitions() []map[string]interface{} {
+	pathProperty := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":        "string",
+			"description": description,
+		}
+	}
+
+	tools := []map[string]interface{}{
+		{
+			"name":        "bruin_list_pipelines",
+			"description": "List the Bruin pipelines found under the given directory, with their names and paths.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": pathProperty("Directory to search for pipelines, defaults to the current working directory."),
+				},
+			},
+		},
+		{
+			"name":        "bruin_list_assets",
+			"description": "List the assets of a Bruin pipeline with their types, definition files and upstream dependencies.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": pathProperty("Path to the pipeline directory or to any file inside it."),
+				},
+				"required": []string{"path"},
+			},
+		},
+		{
+			"name":        "bruin_get_asset",
+			"description": "Get the fully parsed definition of an asset as JSON, including its columns, checks, materialization and upstreams.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": pathProperty("Path to the asset file."),
+				},
+				"required": []string{"path"},
+			},
+		},
+		{
+			"name":        "bruin_render_asset",
+			"description": "Render the SQL query of an asset with its Jinja templates and variables resolved.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": pathProperty("Path to the asset file."),
+				},
+				"required": []string{"path"},
+			},
+		},
+		{
+			"name":        "bruin_lint",
+			"description": "Validate a Bruin pipeline or asset and return the issues found as JSON.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": pathProperty("Path to a pipeline, an asset or a directory with multiple pipelines."),
+				},
+				"required": []string{"path"},
+			},
+		},
+		{
+			"name":        "bruin_get_lineage",
+			"description": "Get the upstream and downstream dependencies of an asset as JSON.",
+			"inputSchema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": pathProperty("Path to the asset file."),
+					"full": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Include all the transitive dependencies instead of only the direct ones.",
+					},
+				},
+				"required": []string{"path"},
+			},
+		},
+	}
+
+	if len(t.allowedQueryConnections) == 0 {
+		return tools
+	}
+
+	return append(tools, map[string]interface{}{
+		"name":        "bruin_query",
+		"description": "Run a read-only query on one of the allowed connections and return the results as JSON. Allowed connections: " + strings.Join(t.allowedQueryConnections, ", "),
+		"inputSchema": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"connection": map[string]interface{}{
+					"type":        "string",
+					"description": "Name of the connection to run the query on.",
+					"enum":        t.allowedQueryConnections,
+				},
+				"query": map[string]interface{}{
+					"type":        "string",
+					"description": "A single SELECT, WITH, SHOW, DESCRIBE or EXPLAIN statement.",
+				},
+				"limit": map[string]interface{}{
+					"type":        "integer",
+					"description": "Maximum number of rows to return.",
+				},
+				"environment": map[string]interface{}{
+					"type":        "string",
+					"description": "Environment to pick the connection from, defaults to the default environment.",
+				},
+			},
+			"required": []string{"connection", "query"},
+		},
+	})
+}
+
+// handles reports whether the tool with the given name is one of the project tools.
+func (t *projectTools) handles(toolName string) bool {
+	return slices.ContainsFunc(t.definitions(), func(tool map[string]interface{}) bool {
+		return tool["name"] == toolName
+	})
+}
+
+// call executes the given project tool and returns its text output.
+func (t *projectTools) call(ctx context.Context, toolName string, args map[string]interface{}) (string, error) {
+	switch toolName {
+	case "bruin_list_pipelines":
+		root := stringArgument(args, "path")
+		if root == "" {
+			root = "."
+		}
+		return listPipelines(root)
+	case "bruin_list_assets":
+		assetPath, err := requiredStringArgument(args, "path")
+		if err != nil {
+			return "", err
+		}
+		return t.listAssets(ctx, assetPath)
+	case "bruin_get_asset":
+		assetPath, err := requiredStringArgument(args, "path")
+		if err != nil {
+			return "", err
+		}
+		return t.runText(ctx, "internal", "parse-asset", "--", assetPath)
+	case "bruin_render_asset":
+		assetPath, err := requiredStringArgument(args, "path")
+		if err != nil {
+			return "", err
+		}
+		return t.runText(ctx, "render", "--output", "json", "--", assetPath)
+	case "bruin_lint":
+		lintPath, err := requiredStringArgument(args, "path")
+		if err != nil {
+			return "", err
+		}
+		return t.runText(ctx, "validate", "--output", "json", "--", lintPath)
+	case "bruin_get_lineage":
+		assetPath, err := requiredStringArgument(args, "path")
+		if err != nil {
+			return "", err
+		}
+		cliArgs := []string{"lineage", "--output", "json"}
+		if full, _ := args["full"].(bool); full {
+			cliArgs = append(cliArgs, "--full")
+		}
+		return t.runText(ctx, append(cliArgs, "--", assetPath)...)
+	case "bruin_query":
+		return t.query(ctx, args)
+	default:
+		return "", fmt.Errorf("unknown tool: %s", toolName)
+	}
+}
+
+func (t *projectTools) runText(ctx context.Context, args ...string) (string, error) {
+	output, err := t.run(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+type pipelineListing struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+func listPipelines(root string) (string, error) {
+	pipelinePaths, err := path.GetPipelinePaths(root, pipelineDefinitionFiles)
+	if err != nil {
+		return "", err
+	}
+
+	fs := afero.NewOsFs()
+	pipelines := make([]pipelineListing, 0, len(pipelinePaths))
+	for _, pipelinePath := range pipelinePaths {
+		for _, definitionFile := range pipelineDefinitionFiles {
+			definitionPath := filepath.Join(pipelinePath, definitionFile)
+			if exists, _ := afero.Exists(fs, definitionPath); !exists {
+				continue
+			}
+
+			p, err := pipeline.PipelineFromPath(definitionPath, fs)
+			if err != nil {
+				return "", fmt.Errorf("failed to parse pipeline '%s': %w", definitionPath, err)
+			}
+
+			pipelines = append(pipelines, pipelineListing{Name: p.Name, Path: pipelinePath})
+			break
+		}
+	}
+
+	return marshalIndent(pipelines)
+}
+
+type assetListing struct {
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Path      string   `json:"path"`
+	Upstreams []string `json:"upstreams"`
+}
+
+func (t *projectTools) listAssets(ctx context.Context, pipelinePath string) (string, error) {
+	output, err := t.run(ctx, "internal", "parse-pipeline", "--exp-slim-response", "--", pipelinePath)
+	if err != nil {
+		return "", err
+	}
+
+	var parsed struct {
+		Assets []struct {
+			Name           string `json:"name"`
+			Type           string `json:"type"`
+			DefinitionFile struct {
+				Path string `json:"path"`
+			} `json:"definition_file"`
+			Upstreams []struct {
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			} `json:"upstreams"`
+		} `json:"assets"`
+	}
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse the pipeline output: %w", err)
+	}
+
+	assets := make([]assetListing, 0, len(parsed.Assets))
+	for _, asset := range parsed.Assets {
+		upstreams := make([]string, 0, len(asset.Upstreams))
+		for _, upstream := range asset.Upstreams {
+			upstreams = append(upstreams, upstream.Value)
+		}
+
+		assets = append(assets, assetListing{
+			Name:      asset.Name,
+			Type:      asset.Type,
+			Path:      asset.DefinitionFile.Path,
+			Upstreams: upstreams,
+		})
+	}
+
+	return marshalIndent(assets)
+}
+
+func (t *projectTools) query(ctx context.Context, args map[string]interface{}) (string, error) {
+	connection, err := requiredStringArgument(args, "connection")
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains(t.allowedQueryConnections, connection) {
+		return "", fmt.Errorf("connection '%s' is not allowed to be queried, allowed connections: %s", connection, strings.Join(t.allowedQueryConnections, ", "))
+	}
+
+	query, err := requiredStringArgument(args, "query")
+	if err != nil {
+		return "", err
+	}
+
+	if err := ensureReadOnlyQuery(query); err != nil {
+		return "", err
+	}
+
+	// the values come from the MCP client, they are attached to their flags so that a value starting with a dash,
+	// e.g. a query starting with a comment, is not parsed as a flag
+	cliArgs := []string{"query", "--output", "json", "--connection=" + connection, "--query=" + query}
+	if limit, ok := args["limit"].(float64); ok && limit > 0 {
+		cliArgs = append(cliArgs, "--limit", strconv.FormatInt(int64(limit), 10))
+	}
+	if environment := stringArgument(args, "environment"); environment != "" {
+		cliArgs = append(cliArgs, "--environment="+environment)
+	}
+
+	return t.runText(ctx, cliArgs...)
+}
+
+// ensureReadOnlyQuery rejects anything other than a single statement that reads data. This is a safeguard on
+// top of the connection allowlist, the credentials of allowed connections should still be read-only.
+func ensureReadOnlyQuery(query string) error {
+	return helpers.EnsureReadOnlyQuery(query, "select", "with", "show", "describe", "desc", "explain")
+}
+
+func stringArgument(args map[string]interface{}, name string) string {
+	value, _ := args[name].(string)
+	return value
+}
+
+func requiredStringArgument(args map[string]interface{}, name string) (string, error) {
+	value := stringArgument(args, name)
+	if value == "" {
+		return "", fmt.Errorf("missing or invalid %s parameter", name)
+	}
+
+	return value, nil
+}
+
+func marshalIndent(v interface{}) (string, error) {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
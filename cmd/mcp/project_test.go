@@ -0,0 +1,230 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunner struct {
+	calls  [][]string
+	output string
+	err    error
+}
+
+func (f *fakeRunner) run(_ context.Context, args ...string) ([]byte, error) {
+	f.calls = append(f.calls, args)
+	return []byte(f.output), f.err
+}
+
+func newTestProjectTools(allowed []string, output string) (*projectTools, *fakeRunner) {
+	runner := &fakeRunner{output: output}
+	return &projectTools{allowedQueryConnections: allowed, run: runner.run}, runner
+}
+
+func TestProjectTools_Definitions(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestProjectTools(nil, "")
+	assert.True(t, tools.handles("bruin_get_asset"))
+	assert.False(t, tools.handles("bruin_query"))
+
+	tools, _ = newTestProjectTools([]string{"duckdb-default"}, "")
+	assert.True(t, tools.handles("bruin_query"))
+}
+
+func TestProjectTools_CallInvokesCLI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tool     string
+		args     map[string]interface{}
+		expected []string
+	}{
+		{
+			name:     "parse asset",
+			tool:     "bruin_get_asset",
+			args:     map[string]interface{}{"path": "assets/orders.sql"},
+			expected: []string{"internal", "parse-asset", "--", "assets/orders.sql"},
+		},
+		{
+			name:     "render asset",
+			tool:     "bruin_render_asset",
+			args:     map[string]interface{}{"path": "assets/orders.sql"},
+			expected: []string{"render", "--output", "json", "--", "assets/orders.sql"},
+		},
+		{
+			name:     "lint",
+			tool:     "bruin_lint",
+			args:     map[string]interface{}{"path": "."},
+			expected: []string{"validate", "--output", "json", "--", "."},
+		},
+		{
+			name:     "full lineage",
+			tool:     "bruin_get_lineage",
+			args:     map[string]interface{}{"path": "assets/orders.sql", "full": true},
+			expected: []string{"lineage", "--output", "json", "--full", "--", "assets/orders.sql"},
+		},
+		{
+			name:     "path that looks like a flag",
+			tool:     "bruin_lint",
+			args:     map[string]interface{}{"path": "--help"},
+			expected: []string{"validate", "--output", "json", "--", "--help"},
+		},
+		{
+			name:     "query",
+			tool:     "bruin_query",
+			args:     map[string]interface{}{"connection": "duckdb-default", "query": "select 1", "limit": float64(10)},
+			expected: []string{"query", "--output", "json", "--connection=duckdb-default", "--query=select 1", "--limit", "10"},
+		},
+		{
+			name:     "query starting with a comment",
+			tool:     "bruin_query",
+			args:     map[string]interface{}{"connection": "duckdb-default", "query": "-- latest orders\nselect 1", "environment": "dev"},
+			expected: []string{"query", "--output", "json", "--connection=duckdb-default", "--query=-- latest orders\nselect 1", "--environment=dev"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tools, runner := newTestProjectTools([]string{"duckdb-default"}, "{\"ok\": true}\n")
+			text, err := tools.call(t.Context(), tt.tool, tt.args)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"ok": true}`, text)
+			require.Len(t, runner.calls, 1)
+			assert.Equal(t, tt.expected, runner.calls[0])
+		})
+	}
+}
+
+func TestProjectTools_CallRequiresPath(t *testing.T) {
+	t.Parallel()
+
+	tools, runner := newTestProjectTools(nil, "")
+	_, err := tools.call(t.Context(), "bruin_render_asset", map[string]interface{}{})
+	require.EqualError(t, err, "missing or invalid path parameter")
+	assert.Empty(t, runner.calls)
+}
+
+func TestProjectTools_ListAssets(t *testing.T) {
+	t.Parallel()
+
+	output := `{"name": "daily", "assets": [
+		{"name": "raw.orders", "type": "duckdb.sql", "definition_file": {"path": "/p/assets/orders.sql"}, "upstreams": []},
+		{"name": "mart.revenue", "type": "duckdb.sql", "definition_file": {"path": "/p/assets/revenue.sql"}, "upstreams": [{"type": "asset", "value": "raw.orders"}]}
+	]}`
+	tools, runner := newTestProjectTools(nil, output)
+
+	text, err := tools.call(t.Context(), "bruin_list_assets", map[string]interface{}{"path": "/p"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"internal", "parse-pipeline", "--exp-slim-response", "--", "/p"}, runner.calls[0])
+
+	var assets []assetListing
+	require.NoError(t, json.Unmarshal([]byte(text), &assets))
+	assert.Equal(t, []assetListing{
+		{Name: "raw.orders", Type: "duckdb.sql", Path: "/p/assets/orders.sql", Upstreams: []string{}},
+		{Name: "mart.revenue", Type: "duckdb.sql", Path: "/p/assets/revenue.sql", Upstreams: []string{"raw.orders"}},
+	}, assets)
+}
+
+func TestProjectTools_ListPipelines(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	for dir, name := range map[string]string{"first": "first_pipeline", "nested/second": "second_pipeline"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, dir), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, dir, "pipeline.yml"), []byte("name: "+name+"\n"), 0o600))
+	}
+
+	tools, _ := newTestProjectTools(nil, "")
+	text, err := tools.call(t.Context(), "bruin_list_pipelines", map[string]interface{}{"path": root})
+	require.NoError(t, err)
+
+	var pipelines []pipelineListing
+	require.NoError(t, json.Unmarshal([]byte(text), &pipelines))
+	assert.ElementsMatch(t, []pipelineListing{
+		{Name: "first_pipeline", Path: filepath.Join(root, "first")},
+		{Name: "second_pipeline", Path: filepath.Join(root, "nested", "second")},
+	}, pipelines)
+}
+
+func TestProjectTools_QueryRejectsConnectionsOutsideAllowlist(t *testing.T) {
+	t.Parallel()
+
+	tools, runner := newTestProjectTools([]string{"duckdb-default"}, "")
+	_, err := tools.call(t.Context(), "bruin_query", map[string]interface{}{"connection": "bq-prod", "query": "select 1"})
+	require.EqualError(t, err, "connection 'bq-prod' is not allowed to be queried, allowed connections: duckdb-default")
+	assert.Empty(t, runner.calls)
+}
+
+func TestEnsureReadOnlyQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query   string
+		wantErr string
+	}{
+		{query: "SELECT * FROM orders;"},
+		{query: "-- top customers\nwith c as (select 1) select * from c"},
+		{query: "select * from logs where message = 'delete me; now'"},
+		{query: "describe orders"},
+		{query: "", wantErr: "query is empty"},
+		{query: "select 1; drop table orders", wantErr: "only a single statement is allowed"},
+		{query: "insert into orders values (1)", wantErr: "the query must start with SELECT"},
+		{query: "with deleted as (delete from orders returning *) select * from deleted", wantErr: "found 'DELETE'"},
+		{query: "select * into new_orders from orders", wantErr: "found 'INTO'"},
+		{query: "with c as (select 1 as id) select * into new_orders from c", wantErr: "found 'INTO'"},
+		{query: "select replace(name, 'a', 'b') as name from orders"},
+		{query: "select * replace (upper(name) as name) from orders"},
+		{query: "with o as (select * from orders) replace into archived_orders select * from o", wantErr: "found 'REPLACE'"},
+		{query: "with o as (select 1) unload ('select * from orders') to 's3://bucket/orders/'", wantErr: "found 'UNLOAD'"},
+		{query: "select export, id from orders"},
+		{query: "with o as (select * from orders) export data options (uri = 'gs://bucket/*.csv') as select * from o", wantErr: "found 'EXPORT DATA'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			t.Parallel()
+
+			err := ensureReadOnlyQuery(tt.query)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestHandleToolCall_ProjectToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, runner := newTestProjectTools(nil, "")
+	runner.err = errors.New("bruin render failed: asset not found")
+
+	response := handleToolCall(t.Context(), JSONRPCRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "tools/call",
+		Params: map[string]interface{}{
+			"name":      "bruin_render_asset",
+			"arguments": map[string]interface{}{"path": "missing.sql"},
+		},
+	}, false, tools)
+
+	require.Nil(t, response.Error)
+	result, ok := response.Result.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, true, result["isError"])
+	assert.Equal(t, "Error: bruin render failed: asset not found", result["content"].([]map[string]interface{})[0]["text"])
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/bruin-data/bruin/pkg/notification"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+)
+
+const notificationTimeout = time.Minute
+
+type runNotification struct {
+	notifications *pipeline.Notifications
+	event         *notification.Event
+}
+
+// sendRunNotifications delivers the pipeline notifications for the outcome of the run, as well as the failure
+// notifications of the individual assets and checks. Delivery errors are reported but never fail the run.
+func sendRunNotifications(ctx context.Context, p *pipeline.Pipeline, connections *config.Connections, runID string, results []*scheduler.TaskExecutionResult, s *scheduler.Scheduler, duration time.Duration) {
+	summary := analyzeResults(results, s)
+	summary.Duration = duration
+
+	runNotifications := buildRunNotifications(p, runID, results, summary)
+	if len(runNotifications) == 0 {
+		return
+	}
+
+	// the run context might have been cancelled already, the notifications should still go out
+	notifyCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notificationTimeout)
+	defer cancel()
+
+	notifier := notification.NewNotifier(connections)
+	for _, n := range runNotifications {
+		if err := notifier.Send(notifyCtx, n.notifications, n.event); err != nil {
+			warningPrinter.Printf("Failed to deliver notifications for pipeline '%s': %v\n", p.Name, err)
+		}
+	}
+}
+
+func buildRunNotifications(p *pipeline.Pipeline, runID string, results []*scheduler.TaskExecutionResult, summary ExecutionSummary) []*runNotification {
+	summaryText := formatNotificationSummary(summary)
+	runNotifications := make([]*runNotification, 0)
+
+	status := notification.StatusSuccess
+	if summary.FailedTasks > 0 {
+		status = notification.StatusFailure
+	}
+
+	if hasNotificationTargets(&p.Notifications) {
+		runNotifications = append(runNotifications, &runNotification{
+			notifications: &p.Notifications,
+			event: &notification.Event{
+				Pipeline: p.Name,
+				RunID:    runID,
+				Status:   status,
+				Summary:  summaryText,
+			},
+		})
+	}
+
+	for _, result := range results {
+		if result.Error == nil {
+			continue
+		}
+
+		event := &notification.Event{
+			Pipeline: p.Name,
+			RunID:    runID,
+			Status:   notification.StatusFailure,
+			Summary:  summaryText,
+			Error:    result.Error.Error(),
+		}
+
+		var notifications *pipeline.Notifications
+		switch instance := result.Instance.(type) {
+		case *scheduler.AssetInstance:
+			notifications = instance.Asset.Notifications
+			event.Asset = instance.Asset.Name
+		case *scheduler.ColumnCheckInstance:
+			notifications = instance.Check.Notifications
+			if notifications == nil {
+				notifications = instance.Asset.Notifications
+			}
+			event.Asset = instance.Asset.Name
+			event.Column = instance.Column.Name
+			event.Check = instance.Check.Name
+		case *scheduler.CustomCheckInstance:
+			notifications = instance.Check.Notifications
+			if notifications == nil {
+				notifications = instance.Asset.Notifications
+			}
+			event.Asset = instance.Asset.Name
+			event.Check = instance.Check.Name
+		case *scheduler.TableCheckInstance:
+			notifications = instance.Check.Notifications
+			if notifications == nil {
+				notifications = instance.Asset.Notifications
+			}
+			event.Asset = instance.Asset.Name
+			event.Column = instance.Check.Column
+			event.Check = instance.Check.Name
+		}
+
+		if !hasNotificationTargets(notifications) {
+			continue
+		}
+
+		runNotifications = append(runNotifications, &runNotification{notifications: notifications, event: event})
+	}
+
+	return runNotifications
+}
+
+func hasNotificationTargets(n *pipeline.Notifications) bool {
+	if n == nil {
+		return false
+	}
+
+	return len(n.Slack)+len(n.MSTeams)+len(n.Discord)+len(n.Webhook) > 0
+}
+
+// formatNotificationSummary renders the execution summary as plain text, without the colors used in the terminal.
+func formatNotificationSummary(summary ExecutionSummary) string {
+	lines := []string{fmt.Sprintf("Duration: %s", summary.Duration.Truncate(time.Millisecond))}
+
+	if summary.Assets.HasAny() {
+		lines = append(lines, "Assets: "+formatNotificationCounts(summary.Assets))
+	}
+
+	checks := TaskTypeStats{
+		Total:     summary.ColumnChecks.Total + summary.CustomChecks.Total + summary.TableChecks.Total,
+		Succeeded: summary.ColumnChecks.Succeeded + summary.CustomChecks.Succeeded + summary.TableChecks.Succeeded,
+		Failed:    summary.ColumnChecks.Failed + summary.CustomChecks.Failed + summary.TableChecks.Failed,
+		Skipped:   summary.ColumnChecks.Skipped + summary.CustomChecks.Skipped + summary.TableChecks.Skipped,
+	}
+	if checks.HasAny() {
+		lines = append(lines, "Quality checks: "+formatNotificationCounts(checks))
+	}
+
+	if summary.MetadataPush.HasAny() {
+		lines = append(lines, "Metadata pushes: "+formatNotificationCounts(summary.MetadataPush))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatNotificationCounts(stats TaskTypeStats) string {
+	parts := []string{fmt.Sprintf("%d total", stats.Total), fmt.Sprintf("%d succeeded", stats.Succeeded)}
+	if stats.Failed > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", stats.Failed))
+	}
+	if stats.FailedDueToChecks > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed due to checks", stats.FailedDueToChecks))
+	}
+	if stats.Skipped > 0 {
+		parts = append(parts, fmt.Sprintf("%d skipped", stats.Skipped))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/notification"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildRunNotifications(t *testing.T) {
+	t.Parallel()
+
+	assetNotifications := &pipeline.Notifications{Slack: []pipeline.SlackNotification{{Channel: "#asset"}}}
+	checkNotifications := &pipeline.Notifications{Webhook: []pipeline.WebhookNotification{{Connection: "hook"}}}
+
+	failingAsset := &pipeline.Asset{Name: "orders", Notifications: assetNotifications}
+	silentAsset := &pipeline.Asset{Name: "customers"}
+	column := &pipeline.Column{Name: "id"}
+	check := &pipeline.ColumnCheck{Name: "not_null", Notifications: checkNotifications}
+
+	p := &pipeline.Pipeline{
+		Name:          "daily",
+		Notifications: pipeline.Notifications{MSTeams: []pipeline.MSTeamsNotification{{Connection: "teams"}}},
+	}
+
+	results := []*scheduler.TaskExecutionResult{
+		{Instance: &scheduler.AssetInstance{Asset: failingAsset}, Error: errors.New("asset failed")},
+		{Instance: &scheduler.AssetInstance{Asset: silentAsset}, Error: errors.New("no targets")},
+		{
+			Instance: &scheduler.ColumnCheckInstance{AssetInstance: &scheduler.AssetInstance{Asset: silentAsset}, Column: column, Check: check},
+			Error:    errors.New("check failed"),
+		},
+	}
+
+	summary := ExecutionSummary{FailedTasks: 3, Duration: 2 * time.Second}
+	got := buildRunNotifications(p, "run-1", results, summary)
+	require.Len(t, got, 3)
+
+	assert.Same(t, &p.Notifications, got[0].notifications)
+	assert.Equal(t, notification.StatusFailure, got[0].event.Status)
+	assert.Empty(t, got[0].event.Asset)
+	assert.Equal(t, "run-1", got[0].event.RunID)
+
+	assert.Same(t, assetNotifications, got[1].notifications)
+	assert.Equal(t, "orders", got[1].event.Asset)
+	assert.Equal(t, "asset failed", got[1].event.Error)
+
+	assert.Same(t, checkNotifications, got[2].notifications)
+	assert.Equal(t, "customers", got[2].event.Asset)
+	assert.Equal(t, "id", got[2].event.Column)
+	assert.Equal(t, "not_null", got[2].event.Check)
+}
+
+func TestBuildRunNotifications_Success(t *testing.T) {
+	t.Parallel()
+
+	p := &pipeline.Pipeline{Name: "daily"}
+	assert.Empty(t, buildRunNotifications(p, "run-1", nil, ExecutionSummary{}))
+
+	p.Notifications.Discord = []pipeline.DiscordNotification{{Connection: "discord"}}
+	got := buildRunNotifications(p, "run-1", nil, ExecutionSummary{})
+	require.Len(t, got, 1)
+	assert.Equal(t, notification.StatusSuccess, got[0].event.Status)
+}
+
+func TestFormatNotificationSummary(t *testing.T) {
+	t.Parallel()
+
+	summary := ExecutionSummary{
+		Assets:       TaskTypeStats{Total: 3, Succeeded: 1, Failed: 1, FailedDueToChecks: 1},
+		ColumnChecks: TaskTypeStats{Total: 2, Succeeded: 1, Failed: 1},
+		CustomChecks: TaskTypeStats{Total: 1, Succeeded: 1},
+		Duration:     1500 * time.Millisecond,
+	}
+
+	expected := "Duration: 1.5s\n" +
+		"Assets: 3 total, 1 succeeded, 1 failed, 1 failed due to checks\n" +
+		"Quality checks: 3 total, 2 succeeded, 1 failed"
+	assert.Equal(t, expected, formatNotificationSummary(summary))
+}
+
+func TestFormatNotificationSummary_TableChecks(t *testing.T) {
+	t.Parallel()
+
+	summary := ExecutionSummary{
+		Assets:      TaskTypeStats{Total: 1, FailedDueToChecks: 1},
+		TableChecks: TaskTypeStats{Total: 2, Succeeded: 1, Failed: 1},
+		Duration:    time.Second,
+	}
+
+	expected := "Duration: 1s\n" +
+		"Assets: 1 total, 0 succeeded, 1 failed due to checks\n" +
+		"Quality checks: 2 total, 1 succeeded, 1 failed"
+	assert.Equal(t, expected, formatNotificationSummary(summary))
+}
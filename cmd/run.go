@@ -39,16 +39,21 @@ import (
 	"github.com/bruin-data/bruin/pkg/jinja"
 	"github.com/bruin-data/bruin/pkg/lint"
 	"github.com/bruin-data/bruin/pkg/logger"
+	"github.com/bruin-data/bruin/pkg/looker"
+	"github.com/bruin-data/bruin/pkg/metabase"
 	"github.com/bruin-data/bruin/pkg/mssql"
 	"github.com/bruin-data/bruin/pkg/mysql"
+	"github.com/bruin-data/bruin/pkg/oracle"
 	"github.com/bruin-data/bruin/pkg/path"
 	"github.com/bruin-data/bruin/pkg/pipeline"
 	"github.com/bruin-data/bruin/pkg/postgres"
+	"github.com/bruin-data/bruin/pkg/powerbi"
 	"github.com/bruin-data/bruin/pkg/python"
 	"github.com/bruin-data/bruin/pkg/query"
 	qs "github.com/bruin-data/bruin/pkg/quicksight"
 	"github.com/bruin-data/bruin/pkg/r"
 	"github.com/bruin-data/bruin/pkg/redshift"
+	"github.com/bruin-data/bruin/pkg/runhistory"
 	"github.com/bruin-data/bruin/pkg/s3"
 	"github.com/bruin-data/bruin/pkg/scheduler"
 	"github.com/bruin-data/bruin/pkg/secrets"
@@ -88,10 +93,13 @@ type ExecutionSummary struct {
 	SuccessfulTasks int
 	FailedTasks     int
 	SkippedTasks    int
+	// WarnedTasks are the succeeded checks that reported a warning, they are part of SuccessfulTasks.
+	WarnedTasks int
 
 	Assets       TaskTypeStats
 	ColumnChecks TaskTypeStats
 	CustomChecks TaskTypeStats
+	TableChecks  TaskTypeStats
 	MetadataPush TaskTypeStats
 
 	Duration time.Duration
@@ -103,6 +111,7 @@ type TaskTypeStats struct {
 	Failed            int // Failed in main execution
 	FailedDueToChecks int // Failed only due to checks (main execution succeeded)
 	Skipped           int
+	Warned            int // Succeeded with a warning, part of Succeeded
 }
 
 func (s TaskTypeStats) HasAny() bool {
@@ -137,6 +146,9 @@ func printExecutionTable(results []*scheduler.TaskExecutionResult, s *scheduler.
 		case *scheduler.CustomCheckInstance:
 			key := "custom:" + instance.Check.Name
 			assetResults[assetName][key] = result
+		case *scheduler.TableCheckInstance:
+			key := "table:" + instance.Check.HumanName()
+			assetResults[assetName][key] = result
 		}
 	}
 
@@ -165,6 +177,9 @@ func printExecutionTable(results []*scheduler.TaskExecutionResult, s *scheduler.
 		case *scheduler.CustomCheckInstance:
 			key := "custom:" + instance.Check.Name
 			assetResults[assetName][key] = upstreamFailedResult
+		case *scheduler.TableCheckInstance:
+			key := "table:" + instance.Check.HumanName()
+			assetResults[assetName][key] = upstreamFailedResult
 		}
 	}
 
@@ -218,8 +233,10 @@ func printExecutionTable(results []*scheduler.TaskExecutionResult, s *scheduler.
 				// Check if upstream failed
 				_, isUpstreamFailed := find(upstreamFailedTasks, result.Instance)
 
-				if isUpstreamFailed {
+				if isUpstreamFailed { // nolint:gocritic
 					fmt.Print(color.New(color.FgYellow).Sprint("U"))
+				} else if checkWarning(result.Instance) != nil {
+					fmt.Print(color.New(color.FgYellow).Sprint("W"))
 				} else {
 					fmt.Print(color.New(color.FgGreen).Sprint("."))
 				}
@@ -232,6 +249,20 @@ func printExecutionTable(results []*scheduler.TaskExecutionResult, s *scheduler.
 	}
 }
 
+// checkWarning returns the warning a check instance passed with, if any.
+func checkWarning(instance scheduler.TaskInstance) error {
+	switch instance := instance.(type) {
+	case *scheduler.ColumnCheckInstance:
+		return instance.Warning
+	case *scheduler.CustomCheckInstance:
+		return instance.Warning
+	case *scheduler.TableCheckInstance:
+		return instance.Warning
+	}
+
+	return nil
+}
+
 func find(slice []scheduler.TaskInstance, item scheduler.TaskInstance) (int, bool) { // nolint:unparam
 	for i, v := range slice {
 		if v == item {
@@ -252,10 +283,14 @@ func printExecutionSummary(results []*scheduler.TaskExecutionResult, s *schedule
 	hasFailures := summary.FailedTasks > 0
 
 	// Header with status and task count
-	if hasFailures {
+	if hasFailures { // nolint:gocritic
 		summaryPrinter.Printf("\n\nbruin run completed with %s in %s\n\n",
 			color.New(color.FgRed).Sprint("failures"),
 			duration.Truncate(time.Millisecond).String())
+	} else if summary.WarnedTasks > 0 {
+		summaryPrinter.Printf("\n\nbruin run completed with %s in %s\n\n",
+			color.New(color.FgYellow).Sprint("warnings"),
+			duration.Truncate(time.Millisecond).String())
 	} else {
 		summaryPrinter.Printf("\n\nbruin run completed %s in %s\n\n",
 			color.New(color.FgGreen).Sprint("successfully"),
@@ -276,18 +311,27 @@ func printExecutionSummary(results []*scheduler.TaskExecutionResult, s *schedule
 	}
 
 	// Quality checks
-	totalChecks := summary.ColumnChecks.Total + summary.CustomChecks.Total
-	totalCheckFailures := summary.ColumnChecks.Failed + summary.CustomChecks.Failed
-	totalCheckSkipped := summary.ColumnChecks.Skipped + summary.CustomChecks.Skipped
+	totalChecks := summary.ColumnChecks.Total + summary.CustomChecks.Total + summary.TableChecks.Total
+	totalCheckFailures := summary.ColumnChecks.Failed + summary.CustomChecks.Failed + summary.TableChecks.Failed
+	totalCheckSkipped := summary.ColumnChecks.Skipped + summary.CustomChecks.Skipped + summary.TableChecks.Skipped
+	totalCheckWarnings := summary.ColumnChecks.Warned + summary.CustomChecks.Warned + summary.TableChecks.Warned
 	if totalChecks > 0 {
-		if totalCheckFailures > 0 || totalCheckSkipped > 0 {
+		if totalCheckFailures > 0 || totalCheckSkipped > 0 { // nolint:gocritic
+			counts := formatCountWithSkipped(totalChecks, totalCheckFailures, 0, totalCheckSkipped)
+			if totalCheckWarnings > 0 {
+				counts += " / " + color.New(color.FgYellow).Sprintf("%d warned", totalCheckWarnings)
+			}
 			summaryPrinter.Printf(" %s Quality checks       %s\n",
-				color.New(color.FgRed).Sprint("✗"),
-				formatCountWithSkipped(totalChecks, totalCheckFailures, 0, totalCheckSkipped))
+				color.New(color.FgRed).Sprint("✗"), counts)
+		} else if totalCheckWarnings > 0 {
+			summaryPrinter.Printf(" %s Quality checks       %s / %s\n",
+				color.New(color.FgYellow).Sprint("!"),
+				color.New(color.FgYellow).Sprintf("%d warned", totalCheckWarnings),
+				color.New(color.FgGreen).Sprintf("%d succeeded", totalChecks-totalCheckWarnings))
 		} else {
 			summaryPrinter.Printf(" %s Quality checks       %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
-				color.New(color.FgGreen).Sprintf("%d succeeded", summary.ColumnChecks.Succeeded+summary.CustomChecks.Succeeded))
+				color.New(color.FgGreen).Sprintf("%d succeeded", summary.ColumnChecks.Succeeded+summary.CustomChecks.Succeeded+summary.TableChecks.Succeeded))
 		}
 	}
 
@@ -303,6 +347,10 @@ func printExecutionSummary(results []*scheduler.TaskExecutionResult, s *schedule
 				color.New(color.FgGreen).Sprint("✓"), metadataExecuted)
 		}
 	}
+
+	if summary.WarnedTasks > 0 {
+		printWarningsInResults(results)
+	}
 }
 
 func formatCount(total, failed int) string {
@@ -356,11 +404,15 @@ func analyzeResults(results []*scheduler.TaskExecutionResult, s *scheduler.Sched
 
 		// Determine if task succeeded
 		succeeded := result.Error == nil
+		warned := succeeded && checkWarning(result.Instance) != nil
 		if succeeded {
 			summary.SuccessfulTasks++
 		} else {
 			summary.FailedTasks++
 		}
+		if warned {
+			summary.WarnedTasks++
+		}
 
 		// Categorize by task type
 		switch instance := result.Instance.(type) {
@@ -381,6 +433,9 @@ func analyzeResults(results []*scheduler.TaskExecutionResult, s *scheduler.Sched
 			} else {
 				summary.ColumnChecks.Failed++
 			}
+			if warned {
+				summary.ColumnChecks.Warned++
+			}
 		case *scheduler.CustomCheckInstance:
 			assetName := instance.GetAsset().Name
 			assetNames[assetName] = true
@@ -393,6 +448,24 @@ func analyzeResults(results []*scheduler.TaskExecutionResult, s *scheduler.Sched
 			} else {
 				summary.CustomChecks.Failed++
 			}
+			if warned {
+				summary.CustomChecks.Warned++
+			}
+		case *scheduler.TableCheckInstance:
+			assetName := instance.GetAsset().Name
+			assetNames[assetName] = true
+			if !succeeded {
+				assetHasCheckFailures[assetName] = true
+			}
+			summary.TableChecks.Total++
+			if succeeded {
+				summary.TableChecks.Succeeded++
+			} else {
+				summary.TableChecks.Failed++
+			}
+			if warned {
+				summary.TableChecks.Warned++
+			}
 		case *scheduler.MetadataPushInstance:
 			summary.MetadataPush.Total++
 			if succeeded {
@@ -451,6 +524,9 @@ func analyzeResults(results []*scheduler.TaskExecutionResult, s *scheduler.Sched
 		case *scheduler.CustomCheckInstance:
 			summary.CustomChecks.Total++
 			summary.CustomChecks.Skipped++
+		case *scheduler.TableCheckInstance:
+			summary.TableChecks.Total++
+			summary.TableChecks.Skipped++
 		case *scheduler.MetadataPushInstance:
 			summary.MetadataPush.Total++
 			summary.MetadataPush.Skipped++
@@ -532,6 +608,19 @@ func Run(isDebug *bool) *cli.Command {
 				Name:  "no-log-file",
 				Usage: "do not create a log file for this run",
 			},
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "the output type, possible values are: plain, json. With json, the results are printed to stdout and the logs to stderr",
+			},
+			&cli.StringFlag{
+				Name:  "junit-report",
+				Usage: "write the results of the run to the given file as a JUnit XML report",
+			},
+			&cli.BoolFlag{
+				Name:  "no-notifications",
+				Usage: "do not send the notifications defined in the pipeline and its assets",
+			},
 			&cli.StringFlag{
 				Name:        "sensor-mode",
 				DefaultText: "'once' (default), 'skip', 'wait'",
@@ -652,6 +741,19 @@ func Run(isDebug *bool) *cli.Command {
 				Usage: "timeout for the entire pipeline run in seconds",
 				Value: 604800, // 7 days default
 			},
+			&cli.BoolFlag{
+				Name:  "backfill",
+				Usage: "split the date range into the intervals of the pipeline schedule and run each interval separately",
+			},
+			&cli.IntFlag{
+				Name:  "backfill-concurrency",
+				Usage: "number of backfill intervals to run in parallel, intervals run in order when set to 1",
+				Value: 1,
+			},
+			&cli.IntFlag{
+				Name:  "failing-rows-sample",
+				Usage: "number of rows violating a failed check to fetch, store under the logs folder and print in the error summary, disabled when 0",
+			},
 			&cli.StringFlag{
 				Name:  "query-annotations",
 				Usage: fmt.Sprintf("JSON string containing annotations to be added as comments to queries. Use '%s' to only include default annotations.", ansisql.DefaultQueryAnnotations),
@@ -717,6 +819,29 @@ func Run(isDebug *bool) *cli.Command {
 				ApplyIntervalModifiers: applyIntervalModifiers,
 				Annotations:            c.String("query-annotations"),
 				UseGong:                useGong,
+				FailingRowsSample:      c.Int("failing-rows-sample"),
+			}
+
+			if runConfig.FailingRowsSample < 0 {
+				errorPrinter.Printf("Invalid value for '--failing-rows-sample' flag: '%d', it cannot be negative\n", runConfig.FailingRowsSample)
+				return cli.Exit("", 1)
+			}
+
+			if runConfig.Output != "" && runConfig.Output != "plain" && runConfig.Output != "json" {
+				errorPrinter.Printf("Invalid value for '--output' flag: '%s', valid options are: plain, json\n", runConfig.Output)
+				return cli.Exit("", 1)
+			}
+
+			// stdout is reserved for the results with --output json, everything meant for humans goes to stderr instead
+			resultsOutput := os.Stdout
+			if runConfig.Output == "json" {
+				previousColorOutput := color.Output
+				os.Stdout = os.Stderr
+				color.Output = os.Stderr
+				defer func() {
+					os.Stdout = resultsOutput
+					color.Output = previousColorOutput
+				}()
 			}
 
 			var startDate, endDate time.Time
@@ -749,10 +874,12 @@ func Run(isDebug *bool) *cli.Command {
 				// schema prefix implies a developer environment being configured where different assets within this
 				// execution will be built under prefixed schemas. This requires not just modifying the queries,
 				// but also modifying the asset names so that quality checks actually run against the tables in the new schema.
-				// Since we change the asset names, we need to also prefix the upstream since their names would be changed as well.
+				// Since we change the asset names, we need to also prefix the upstream since their names would be changed as well,
+				// and so are the assets the relationships checks refer to.
 				DefaultPipelineBuilder.AddAssetMutator(func(ctx context.Context, asset *pipeline.Asset, foundPipeline *pipeline.Pipeline) (*pipeline.Asset, error) {
 					asset.PrefixSchema(cm.SelectedEnvironment.SchemaPrefix)
 					asset.PrefixUpstreams(cm.SelectedEnvironment.SchemaPrefix)
+					asset.PrefixCheckReferences(cm.SelectedEnvironment.SchemaPrefix)
 					return asset, nil
 				})
 			}
@@ -787,6 +914,7 @@ func Run(isDebug *bool) *cli.Command {
 			runCtx = context.WithValue(runCtx, pipeline.RunConfigRunID, runID)
 			runCtx = context.WithValue(runCtx, pipeline.RunConfigFullRefresh, runConfig.FullRefresh)
 			runCtx = context.WithValue(runCtx, pipeline.RunConfigQueryAnnotations, runConfig.Annotations)
+			runCtx = context.WithValue(runCtx, pipeline.RunConfigFailingRowsSample, runConfig.FailingRowsSample)
 			runCtx = context.WithValue(runCtx, config.SecretsBackendContextKey, c.String("secrets-backend"))
 
 			// Preview load uses WithOnlyPipeline so we can introspect the
@@ -816,6 +944,10 @@ func Run(isDebug *bool) *cli.Command {
 				}
 			}
 
+			if c.Bool("backfill") {
+				return runBackfillCommand(runCtx, c, preview.Pipeline, runConfig, repoRoot.Path, startDate, endDate)
+			}
+
 			var task *pipeline.Asset
 			if preview.RunningForAnAsset && c.Args().Len() == 1 {
 				task, err = DefaultPipelineBuilder.CreateAssetFromFile(inputPath, preview.Pipeline)
@@ -1140,6 +1272,9 @@ func Run(isDebug *bool) *cli.Command {
 				return cli.Exit("", 1)
 			}
 
+			// run_query and get_column_values only reach the connections during a run, the other commands get stubs
+			renderer.SetIntrospector(query.NewIntrospector(connectionManager))
+
 			foundPipeline := pipelineInfo.Pipeline
 
 			if runConfig.Downstream {
@@ -1162,6 +1297,7 @@ func Run(isDebug *bool) *cli.Command {
 				realTerminal = os.Stdout
 			}
 
+			var logPath string
 			if !runConfig.NoLogFile {
 				logFileName := fmt.Sprintf("%s__%s", runID, foundPipeline.Name)
 				if pipelineInfo.RunningForAnAsset {
@@ -1170,7 +1306,7 @@ func Run(isDebug *bool) *cli.Command {
 					logFileName = generateLogFileName(runID, foundPipeline.Name, filter.SelectedAssets)
 				}
 
-				logPath, err := filepath.Abs(fmt.Sprintf("%s/%s/%s.log", repoRoot.Path, LogsFolder, logFileName))
+				logPath, err = filepath.Abs(fmt.Sprintf("%s/%s/%s.log", repoRoot.Path, LogsFolder, logFileName))
 				if err != nil {
 					errorPrinter.Printf("Failed to create log file: %v\n", err)
 					return cli.Exit("", 1)
@@ -1209,6 +1345,7 @@ func Run(isDebug *bool) *cli.Command {
 			}
 
 			s := scheduler.NewScheduler(logger, foundPipeline, runID)
+			s.SetConnectionConcurrencyLimits(cm.SelectedEnvironment.Connections.ConcurrencyLimits())
 
 			if c.Bool("continue") {
 				if err := s.RestoreState(pipelineState); err != nil {
@@ -1264,7 +1401,7 @@ func Run(isDebug *bool) *cli.Command {
 				}()
 			}
 
-			mainExecutors, err := SetupExecutors(s, connectionManager, startDate, endDate, defaultExecutionDate, foundPipeline.Name, runID, runConfig.FullRefresh, runConfig.SensorMode, renderer, parser, foundPipeline.Commit)
+			mainExecutors, err := SetupExecutors(s, connectionManager, startDate, endDate, defaultExecutionDate, foundPipeline.Name, runID, runConfig.FullRefresh, runConfig.SensorMode, renderer, parser, foundPipeline.Commit, runhistory.NewStore(afero.NewOsFs(), runHistoryPath(repoRoot.Path)))
 			if err != nil {
 				errorPrinter.Println(err.Error())
 				return cli.Exit("", 1)
@@ -1341,6 +1478,25 @@ func Run(isDebug *bool) *cli.Command {
 					logger.Error("failed to save pipeline state", zap.Error(err))
 				}
 
+				if runConfig.FailingRowsSample > 0 {
+					if err := saveFailingRows(afero.NewOsFs(), repoRoot.Path, runID, results); err != nil {
+						logger.Error("failed to save the failing rows", zap.Error(err))
+					}
+				}
+
+				runRecord := newRunRecord(foundPipeline, runConfig, runID, logPath, s, results, start)
+				if err := recordRunHistory(afero.NewOsFs(), repoRoot.Path, runRecord); err != nil {
+					logger.Error("failed to record the run history", zap.Error(err))
+				}
+
+				if err := writeRunResults(afero.NewOsFs(), runRecord, runConfig.Output, c.String("junit-report"), resultsOutput); err != nil {
+					errorPrinter.Printf("Failed to write the run results: %v\n", err)
+				}
+
+				if !c.Bool("no-notifications") {
+					sendRunNotifications(runCtx, foundPipeline, cm.SelectedEnvironment.Connections, runID, results, s, duration)
+				}
+
 				errorsInTaskResults := make([]*scheduler.TaskExecutionResult, 0)
 				for _, res := range results {
 					if res.Error != nil {
@@ -1378,6 +1534,25 @@ func Run(isDebug *bool) *cli.Command {
 					logger.Error("failed to save pipeline state", zap.Error(err))
 				}
 
+				if runConfig.FailingRowsSample > 0 {
+					if err := saveFailingRows(afero.NewOsFs(), repoRoot.Path, runID, results); err != nil {
+						logger.Error("failed to save the failing rows", zap.Error(err))
+					}
+				}
+
+				runRecord := newRunRecord(foundPipeline, runConfig, runID, logPath, s, results, start)
+				if err := recordRunHistory(afero.NewOsFs(), repoRoot.Path, runRecord); err != nil {
+					logger.Error("failed to record the run history", zap.Error(err))
+				}
+
+				if err := writeRunResults(afero.NewOsFs(), runRecord, runConfig.Output, c.String("junit-report"), resultsOutput); err != nil {
+					errorPrinter.Printf("Failed to write the run results: %v\n", err)
+				}
+
+				if !c.Bool("no-notifications") {
+					sendRunNotifications(runCtx, foundPipeline, cm.SelectedEnvironment.Connections, runID, results, s, duration)
+				}
+
 				errorsInTaskResults := make([]*scheduler.TaskExecutionResult, 0)
 				for _, res := range results {
 					if res.Error != nil {
@@ -1593,16 +1768,24 @@ func printErrorsInResults(errorsInTaskResults []*scheduler.TaskExecutionResult,
 		for _, result := range results {
 			switch instance := result.Instance.(type) {
 			case *scheduler.ColumnCheckInstance:
-				assetBranch.AddNode(fmt.Sprintf("%s.%s - %s",
+				node := assetBranch.AddBranch(fmt.Sprintf("%s.%s - %s",
 					color.New(color.FgCyan).Sprint(instance.Column.Name),
 					color.New(color.FgMagenta).Sprint(instance.Check.Name),
 					color.New(color.FgRed).Sprintf("%s", result.Error)))
+				addFailingRowsNodes(node, result.Error)
 
 			case *scheduler.CustomCheckInstance:
-				assetBranch.AddNode(fmt.Sprintf("%s %s - %s",
+				node := assetBranch.AddBranch(fmt.Sprintf("%s %s - %s",
 					color.New(color.FgMagenta).Sprint(instance.Check.Name),
 					faint("custom check"),
 					color.New(color.FgRed).Sprintf("%s", result.Error)))
+				addFailingRowsNodes(node, result.Error)
+
+			case *scheduler.TableCheckInstance:
+				assetBranch.AddNode(fmt.Sprintf("%s %s - %s",
+					color.New(color.FgMagenta).Sprint(instance.Check.HumanName()),
+					faint("table check"),
+					color.New(color.FgRed).Sprintf("%s", result.Error)))
 
 			default:
 				assetBranch.AddNode(color.New(color.FgRed).Sprintf("%s", result.Error))
@@ -1613,6 +1796,57 @@ func printErrorsInResults(errorsInTaskResults []*scheduler.TaskExecutionResult,
 	fmt.Println(tree.String())
 }
 
+// printWarningsInResults lists the checks that failed with the "warn" severity, they did not fail the run.
+func printWarningsInResults(results []*scheduler.TaskExecutionResult) {
+	data := make(map[string][]*scheduler.TaskExecutionResult)
+	count := 0
+	for _, result := range results {
+		if result.Error != nil || checkWarning(result.Instance) == nil {
+			continue
+		}
+		assetName := result.Instance.GetAsset().Name
+		data[assetName] = append(data[assetName], result)
+		count++
+	}
+
+	if count == 0 {
+		return
+	}
+
+	fmt.Println()
+	tree := treeprint.NewWithRoot(color.New(color.FgYellow).Sprintf("%d checks warned", count))
+	for assetName, results := range data {
+		assetBranch := tree.AddBranch(color.New(color.FgYellow).Sprint(assetName))
+
+		for _, result := range results {
+			warning := checkWarning(result.Instance)
+			switch instance := result.Instance.(type) {
+			case *scheduler.ColumnCheckInstance:
+				node := assetBranch.AddBranch(fmt.Sprintf("%s.%s - %s",
+					color.New(color.FgCyan).Sprint(instance.Column.Name),
+					color.New(color.FgMagenta).Sprint(instance.Check.Name),
+					color.New(color.FgYellow).Sprintf("%s", warning)))
+				addFailingRowsNodes(node, warning)
+
+			case *scheduler.CustomCheckInstance:
+				node := assetBranch.AddBranch(fmt.Sprintf("%s %s - %s",
+					color.New(color.FgMagenta).Sprint(instance.Check.Name),
+					faint("custom check"),
+					color.New(color.FgYellow).Sprintf("%s", warning)))
+				addFailingRowsNodes(node, warning)
+
+			case *scheduler.TableCheckInstance:
+				assetBranch.AddNode(fmt.Sprintf("%s %s - %s",
+					color.New(color.FgMagenta).Sprint(instance.Check.HumanName()),
+					faint("table check"),
+					color.New(color.FgYellow).Sprintf("%s", warning)))
+			}
+		}
+	}
+	fmt.Println()
+	fmt.Println(tree.String())
+}
+
 func printErrorsMinimal(errorsInTaskResults []*scheduler.TaskExecutionResult) {
 	for _, result := range errorsInTaskResults {
 		assetName := result.Instance.GetAsset().Name
@@ -1630,6 +1864,9 @@ func printSingleCheckError(result *scheduler.TaskExecutionResult) {
 		fmt.Printf("Result: %d (expected: %d)\n\n", checkErr.Result, checkErr.Expected)
 		fmt.Println("Query:")
 		fmt.Println(checkErr.Query + "\n")
+		if checkErr.FailingRows != nil {
+			printFailingRows(checkErr.FailingRows)
+		}
 	} else {
 		fmt.Printf("Error: %s\n", result.Error)
 	}
@@ -1654,6 +1891,13 @@ func printSingleCheckSuccess(result *scheduler.TaskExecutionResult) {
 			fmt.Println("Query:")
 			fmt.Println(instance.ExecutedQuery)
 		}
+	case *scheduler.TableCheckInstance:
+		fmt.Printf("Check: %s (table)\n", instance.Check.HumanName())
+		fmt.Printf("Asset: %s\n\n", instance.GetAsset().Name)
+		if instance.ExecutedQuery != "" {
+			fmt.Println("Query:")
+			fmt.Println(instance.ExecutedQuery)
+		}
 	}
 }
 
@@ -1671,6 +1915,7 @@ func SetupExecutors(
 	renderer *jinja.Renderer,
 	parser *sqlparser.SQLParser,
 	commitHash string,
+	tableCheckHistory ansisql.TableCheckHistory,
 ) (map[pipeline.AssetType]executor.Config, error) {
 	mainExecutors := executor.DefaultExecutorsV2
 
@@ -1701,7 +1946,8 @@ func SetupExecutors(
 		Renderer: renderer,
 	}
 	customCheckRunner := ansisql.NewCustomCheckOperator(conn, renderer)
-	if s.WillRunTaskOfType(pipeline.AssetTypeBigqueryQuery) || estimateCustomCheckType == pipeline.AssetTypeBigqueryQuery || s.WillRunTaskOfType(pipeline.AssetTypeBigquerySeed) || s.WillRunTaskOfType(pipeline.AssetTypeBigqueryQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeBigqueryTableSensor) {
+	tableCheckRunner := ansisql.NewTableCheckOperator(conn, tableCheckHistory, estimateCustomCheckType)
+	if s.WillRunTaskOfType(pipeline.AssetTypeBigqueryQuery) || estimateCustomCheckType == pipeline.AssetTypeBigqueryQuery || s.WillRunTaskOfType(pipeline.AssetTypeBigquerySeed) || s.WillRunTaskOfType(pipeline.AssetTypeBigqueryQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeBigqueryTableSensor) || s.WillRunTaskOfType(pipeline.AssetTypeBigquerySource) {
 		bqOperator := bigquery.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializer{
 			Mat: bigquery.NewMaterializer(fullRefresh),
 		}, parser)
@@ -1717,30 +1963,36 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeBigqueryQuery][scheduler.TaskInstanceTypeMain] = bqOperator
 		mainExecutors[pipeline.AssetTypeBigqueryQuery][scheduler.TaskInstanceTypeColumnCheck] = bqCheckRunner
 		mainExecutors[pipeline.AssetTypeBigqueryQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeBigqueryQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeBigqueryQuery][scheduler.TaskInstanceTypeMetadataPush] = metadataPushOperator
 
 		mainExecutors[pipeline.AssetTypeBigquerySource][scheduler.TaskInstanceTypeMetadataPush] = metadataPushOperator
 		mainExecutors[pipeline.AssetTypeBigquerySource][scheduler.TaskInstanceTypeColumnCheck] = bqCheckRunner
 		mainExecutors[pipeline.AssetTypeBigquerySource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeBigquerySource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeBigqueryTableSensor][scheduler.TaskInstanceTypeMain] = bqTableSensor
 		mainExecutors[pipeline.AssetTypeBigqueryTableSensor][scheduler.TaskInstanceTypeMetadataPush] = metadataPushOperator
 		mainExecutors[pipeline.AssetTypeBigqueryTableSensor][scheduler.TaskInstanceTypeColumnCheck] = bqCheckRunner
 		mainExecutors[pipeline.AssetTypeBigqueryTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeBigqueryTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeBigqueryQuerySensor][scheduler.TaskInstanceTypeMain] = bqQuerySensor
 		mainExecutors[pipeline.AssetTypeBigqueryQuerySensor][scheduler.TaskInstanceTypeMetadataPush] = metadataPushOperator
 		mainExecutors[pipeline.AssetTypeBigqueryQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = bqCheckRunner
 		mainExecutors[pipeline.AssetTypeBigqueryQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeBigqueryQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeBigquerySeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeBigquerySeed][scheduler.TaskInstanceTypeColumnCheck] = bqCheckRunner
 		mainExecutors[pipeline.AssetTypeBigquerySeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeBigquerySeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeBigquerySeed][scheduler.TaskInstanceTypeMetadataPush] = metadataPushOperator
 		// we set the Python runners to run the checks on BigQuery assuming that there won't be many usecases where a user has both BQ and Snowflake
 		if estimateCustomCheckType == pipeline.AssetTypeBigqueryQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = bqCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeMetadataPush] = metadataPushOperator
 		}
 	}
@@ -1748,6 +2000,7 @@ func SetupExecutors(
 	if s.WillRunTaskOfType(pipeline.AssetTypePostgresQuery) || estimateCustomCheckType == pipeline.AssetTypePostgresQuery ||
 		s.WillRunTaskOfType(pipeline.AssetTypeRedshiftQuery) || estimateCustomCheckType == pipeline.AssetTypeRedshiftQuery ||
 		s.WillRunTaskOfType(pipeline.AssetTypeRedshiftSeed) || s.WillRunTaskOfType(pipeline.AssetTypePostgresSeed) ||
+		s.WillRunTaskOfType(pipeline.AssetTypePostgresSource) || s.WillRunTaskOfType(pipeline.AssetTypeRedshiftSource) ||
 		s.WillRunTaskOfType(pipeline.AssetTypePostgresQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeRedshiftQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypePostgresTableSensor) || s.WillRunTaskOfType(pipeline.AssetTypeRedshiftTableSensor) {
 		pgCheckRunner := postgres.NewColumnCheckOperator(conn)
 		pgOperator := postgres.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializer{
@@ -1761,44 +2014,61 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeRedshiftQuery][scheduler.TaskInstanceTypeMain] = pgOperator
 		mainExecutors[pipeline.AssetTypeRedshiftQuery][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypeRedshiftQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeRedshiftQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeRedshiftSource][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
+		mainExecutors[pipeline.AssetTypeRedshiftSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeRedshiftSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypePostgresQuery][scheduler.TaskInstanceTypeMain] = pgOperator
 		mainExecutors[pipeline.AssetTypePostgresQuery][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypePostgresQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypePostgresQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypePostgresQuery][scheduler.TaskInstanceTypeMetadataPush] = pgMetadataPushOperator
 
+		mainExecutors[pipeline.AssetTypePostgresSource][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
+		mainExecutors[pipeline.AssetTypePostgresSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypePostgresSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
 		mainExecutors[pipeline.AssetTypePostgresSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypePostgresSeed][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypePostgresSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypePostgresSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypePostgresSeed][scheduler.TaskInstanceTypeMetadataPush] = pgMetadataPushOperator
 
 		mainExecutors[pipeline.AssetTypeRedshiftSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeRedshiftSeed][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypeRedshiftSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeRedshiftSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypePostgresQuerySensor][scheduler.TaskInstanceTypeMain] = pgQuerySensor
 		mainExecutors[pipeline.AssetTypePostgresQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypePostgresQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypePostgresQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypePostgresQuerySensor][scheduler.TaskInstanceTypeMetadataPush] = pgMetadataPushOperator
 
 		mainExecutors[pipeline.AssetTypePostgresTableSensor][scheduler.TaskInstanceTypeMain] = pgTableSensor
 		mainExecutors[pipeline.AssetTypePostgresTableSensor][scheduler.TaskInstanceTypeMetadataPush] = pgMetadataPushOperator
 		mainExecutors[pipeline.AssetTypePostgresTableSensor][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypePostgresTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypePostgresTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeRedshiftQuerySensor][scheduler.TaskInstanceTypeMain] = pgQuerySensor
 		mainExecutors[pipeline.AssetTypeRedshiftQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypeRedshiftQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeRedshiftQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeRedshiftTableSensor][scheduler.TaskInstanceTypeMain] = rsTableSensor
 		mainExecutors[pipeline.AssetTypeRedshiftTableSensor][scheduler.TaskInstanceTypeMetadataPush] = pgMetadataPushOperator
 		mainExecutors[pipeline.AssetTypeRedshiftTableSensor][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 		mainExecutors[pipeline.AssetTypeRedshiftTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeRedshiftTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		// we set the Python runners to run the checks on Snowflake assuming that there won't be many usecases where a user has both BQ and Snowflake
 		if estimateCustomCheckType == pipeline.AssetTypePostgresQuery || estimateCustomCheckType == pipeline.AssetTypeRedshiftQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = pgCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 	if s.WillRunTaskOfType(pipeline.AssetTypeTrinoQuery) || estimateCustomCheckType == pipeline.AssetTypeTrinoQuery || s.WillRunTaskOfType(pipeline.AssetTypeTrinoQuerySensor) {
@@ -1813,13 +2083,15 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeTrinoQuery][scheduler.TaskInstanceTypeMain] = trinoOperator
 		mainExecutors[pipeline.AssetTypeTrinoQuery][scheduler.TaskInstanceTypeColumnCheck] = trinoCheckRunner
 		mainExecutors[pipeline.AssetTypeTrinoQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeTrinoQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		trinoQuerySensor := ansisql.NewQuerySensor(conn, wholeFileExtractor, sensorMode)
 		mainExecutors[pipeline.AssetTypeTrinoQuerySensor][scheduler.TaskInstanceTypeMain] = trinoQuerySensor
 		mainExecutors[pipeline.AssetTypeTrinoQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = trinoCheckRunner
 		mainExecutors[pipeline.AssetTypeTrinoQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeTrinoQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 	}
-	shouldInitiateSnowflake := s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeQuery) || s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeQuerySensor) || estimateCustomCheckType == pipeline.AssetTypeSnowflakeQuery || s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeSeed) || s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeTableSensor)
+	shouldInitiateSnowflake := s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeQuery) || s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeQuerySensor) || estimateCustomCheckType == pipeline.AssetTypeSnowflakeQuery || s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeSeed) || s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeTableSensor) || s.WillRunTaskOfType(pipeline.AssetTypeSnowflakeSource)
 	if shouldInitiateSnowflake {
 		sfOperator := snowflake.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializer{
 			Mat: snowflake.NewMaterializer(fullRefresh),
@@ -1835,25 +2107,34 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeSnowflakeQuery][scheduler.TaskInstanceTypeMain] = sfOperator
 		mainExecutors[pipeline.AssetTypeSnowflakeQuery][scheduler.TaskInstanceTypeColumnCheck] = sfCheckRunner
 		mainExecutors[pipeline.AssetTypeSnowflakeQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSnowflakeQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeSnowflakeQuerySensor][scheduler.TaskInstanceTypeMain] = sfQuerySensor
 		mainExecutors[pipeline.AssetTypeSnowflakeQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = sfCheckRunner
 		mainExecutors[pipeline.AssetTypeSnowflakeQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSnowflakeQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeSnowflakeQuery][scheduler.TaskInstanceTypeMetadataPush] = sfMetadataPushOperator
 
+		mainExecutors[pipeline.AssetTypeSnowflakeSource][scheduler.TaskInstanceTypeColumnCheck] = sfCheckRunner
+		mainExecutors[pipeline.AssetTypeSnowflakeSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSnowflakeSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
 		mainExecutors[pipeline.AssetTypeSnowflakeTableSensor][scheduler.TaskInstanceTypeMain] = sfTableSensor
 		mainExecutors[pipeline.AssetTypeSnowflakeTableSensor][scheduler.TaskInstanceTypeMetadataPush] = sfMetadataPushOperator
 		mainExecutors[pipeline.AssetTypeSnowflakeTableSensor][scheduler.TaskInstanceTypeColumnCheck] = sfCheckRunner
 		mainExecutors[pipeline.AssetTypeSnowflakeTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSnowflakeTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeSnowflakeSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeSnowflakeSeed][scheduler.TaskInstanceTypeColumnCheck] = sfCheckRunner
 		mainExecutors[pipeline.AssetTypeSnowflakeSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSnowflakeSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeSnowflakeSeed][scheduler.TaskInstanceTypeMetadataPush] = sfMetadataPushOperator
 
 		// we set the Python runners to run the checks on Snowflake assuming that there won't be many usecases where a user has both BQ and Snowflake
 		if estimateCustomCheckType == pipeline.AssetTypeSnowflakeQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = sfCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeMetadataPush] = sfMetadataPushOperator
 		}
 	}
@@ -1862,6 +2143,7 @@ func SetupExecutors(
 	if s.WillRunTaskOfType(pipeline.AssetTypeMsSQLQuery) || estimateCustomCheckType == pipeline.AssetTypeMsSQLQuery ||
 		s.WillRunTaskOfType(pipeline.AssetTypeSynapseQuery) || estimateCustomCheckType == pipeline.AssetTypeSynapseQuery ||
 		s.WillRunTaskOfType(pipeline.AssetTypeMsSQLSeed) || s.WillRunTaskOfType(pipeline.AssetTypeSynapseSeed) ||
+		s.WillRunTaskOfType(pipeline.AssetTypeMsSQLSource) || s.WillRunTaskOfType(pipeline.AssetTypeSynapseSource) ||
 		s.WillRunTaskOfType(pipeline.AssetTypeMsSQLQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeSynapseQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeMsSQLTableSensor) || s.WillRunTaskOfType(pipeline.AssetTypeSynapseTableSensor) {
 		msOperator := mssql.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializer{
 			Mat: mssql.NewMaterializer(fullRefresh),
@@ -1882,48 +2164,66 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeMsSQLQuery][scheduler.TaskInstanceTypeMain] = msOperator
 		mainExecutors[pipeline.AssetTypeMsSQLQuery][scheduler.TaskInstanceTypeColumnCheck] = msCheckRunner
 		mainExecutors[pipeline.AssetTypeMsSQLQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMsSQLQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeMsSQLSource][scheduler.TaskInstanceTypeColumnCheck] = msCheckRunner
+		mainExecutors[pipeline.AssetTypeMsSQLSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMsSQLSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeSynapseQuery][scheduler.TaskInstanceTypeMain] = synapseOperator
 		mainExecutors[pipeline.AssetTypeSynapseQuery][scheduler.TaskInstanceTypeColumnCheck] = synapseCheckRunner
 		mainExecutors[pipeline.AssetTypeSynapseQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSynapseQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeSynapseSource][scheduler.TaskInstanceTypeColumnCheck] = synapseCheckRunner
+		mainExecutors[pipeline.AssetTypeSynapseSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSynapseSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeMsSQLSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeMsSQLSeed][scheduler.TaskInstanceTypeColumnCheck] = msCheckRunner
 		mainExecutors[pipeline.AssetTypeMsSQLSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMsSQLSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeMsSQLQuerySensor][scheduler.TaskInstanceTypeMain] = msQuerySensor
 		mainExecutors[pipeline.AssetTypeMsSQLQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = msCheckRunner
 		mainExecutors[pipeline.AssetTypeMsSQLQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMsSQLQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeMsSQLTableSensor][scheduler.TaskInstanceTypeMain] = msTableSensor
 		mainExecutors[pipeline.AssetTypeMsSQLTableSensor][scheduler.TaskInstanceTypeColumnCheck] = msCheckRunner
 		mainExecutors[pipeline.AssetTypeMsSQLTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMsSQLTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeColumnCheck] = synapseCheckRunner
 		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeSynapseTableSensor][scheduler.TaskInstanceTypeMain] = synapseTableSensor
 		mainExecutors[pipeline.AssetTypeSynapseTableSensor][scheduler.TaskInstanceTypeColumnCheck] = synapseCheckRunner
 		mainExecutors[pipeline.AssetTypeSynapseTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSynapseTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeColumnCheck] = synapseCheckRunner
 		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSynapseSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeSynapseQuerySensor][scheduler.TaskInstanceTypeMain] = synapseQuerySensor
 		mainExecutors[pipeline.AssetTypeSynapseQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = synapseCheckRunner
 		mainExecutors[pipeline.AssetTypeSynapseQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeSynapseQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		// we set the Python runners to run the checks on MsSQL
 		if estimateCustomCheckType == pipeline.AssetTypeMsSQLQuery || estimateCustomCheckType == pipeline.AssetTypeSynapseQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = msCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 
 	if s.WillRunTaskOfType(pipeline.AssetTypeVerticaQuery) || estimateCustomCheckType == pipeline.AssetTypeVerticaQuery ||
-		s.WillRunTaskOfType(pipeline.AssetTypeVerticaSeed) ||
+		s.WillRunTaskOfType(pipeline.AssetTypeVerticaSeed) || s.WillRunTaskOfType(pipeline.AssetTypeVerticaSource) ||
 		s.WillRunTaskOfType(pipeline.AssetTypeVerticaQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeVerticaTableSensor) {
 		verticaOperator := vertica.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializer{
 			Mat: vertica.NewMaterializer(fullRefresh),
@@ -1935,22 +2235,53 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeVerticaQuery][scheduler.TaskInstanceTypeMain] = verticaOperator
 		mainExecutors[pipeline.AssetTypeVerticaQuery][scheduler.TaskInstanceTypeColumnCheck] = verticaCheckRunner
 		mainExecutors[pipeline.AssetTypeVerticaQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeVerticaQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeVerticaSource][scheduler.TaskInstanceTypeColumnCheck] = verticaCheckRunner
+		mainExecutors[pipeline.AssetTypeVerticaSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeVerticaSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeVerticaSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeVerticaSeed][scheduler.TaskInstanceTypeColumnCheck] = verticaCheckRunner
 		mainExecutors[pipeline.AssetTypeVerticaSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeVerticaSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeVerticaQuerySensor][scheduler.TaskInstanceTypeMain] = verticaQuerySensor
 		mainExecutors[pipeline.AssetTypeVerticaQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = verticaCheckRunner
 		mainExecutors[pipeline.AssetTypeVerticaQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeVerticaQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeVerticaTableSensor][scheduler.TaskInstanceTypeMain] = verticaTableSensor
 		mainExecutors[pipeline.AssetTypeVerticaTableSensor][scheduler.TaskInstanceTypeColumnCheck] = verticaCheckRunner
 		mainExecutors[pipeline.AssetTypeVerticaTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeVerticaTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		if estimateCustomCheckType == pipeline.AssetTypeVerticaQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = verticaCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+		}
+	}
+
+	if s.WillRunTaskOfType(pipeline.AssetTypeOracleQuery) || estimateCustomCheckType == pipeline.AssetTypeOracleQuery || s.WillRunTaskOfType(pipeline.AssetTypeOracleSource) {
+		oracleOperator := oracle.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializer{
+			Mat: oracle.NewMaterializer(fullRefresh),
+		})
+		oracleCheckRunner := oracle.NewColumnCheckOperator(conn)
+
+		mainExecutors[pipeline.AssetTypeOracleQuery][scheduler.TaskInstanceTypeMain] = oracleOperator
+		mainExecutors[pipeline.AssetTypeOracleQuery][scheduler.TaskInstanceTypeColumnCheck] = oracleCheckRunner
+		mainExecutors[pipeline.AssetTypeOracleQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeOracleQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeOracleSource][scheduler.TaskInstanceTypeColumnCheck] = oracleCheckRunner
+		mainExecutors[pipeline.AssetTypeOracleSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeOracleSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		if estimateCustomCheckType == pipeline.AssetTypeOracleQuery {
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = oracleCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 
@@ -1972,40 +2303,50 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeFabricQuery][scheduler.TaskInstanceTypeMain] = fabricOperator
 		mainExecutors[pipeline.AssetTypeFabricQuery][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricQueryLegacy][scheduler.TaskInstanceTypeMain] = fabricOperator
 		mainExecutors[pipeline.AssetTypeFabricQueryLegacy][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricQueryLegacy][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricQueryLegacy][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeFabricSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeFabricSeed][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricSeedLegacy][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeFabricSeedLegacy][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricSeedLegacy][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricSeedLegacy][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeFabricQuerySensor][scheduler.TaskInstanceTypeMain] = fabricQuerySensor
 		mainExecutors[pipeline.AssetTypeFabricQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricQuerySensorLegacy][scheduler.TaskInstanceTypeMain] = fabricQuerySensor
 		mainExecutors[pipeline.AssetTypeFabricQuerySensorLegacy][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricQuerySensorLegacy][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricQuerySensorLegacy][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeFabricTableSensor][scheduler.TaskInstanceTypeMain] = fabricTableSensor
 		mainExecutors[pipeline.AssetTypeFabricTableSensor][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricTableSensorLegacy][scheduler.TaskInstanceTypeMain] = fabricTableSensor
 		mainExecutors[pipeline.AssetTypeFabricTableSensorLegacy][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 		mainExecutors[pipeline.AssetTypeFabricTableSensorLegacy][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeFabricTableSensorLegacy][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		if estimateCustomCheckType == pipeline.AssetTypeFabricQuery || estimateCustomCheckType == pipeline.AssetTypeFabricQueryLegacy {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = fabricCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 
 	//nolint:dupl
 	if s.WillRunTaskOfType(pipeline.AssetTypeDatabricksQuery) || estimateCustomCheckType == pipeline.AssetTypeDatabricksQuery ||
-		s.WillRunTaskOfType(pipeline.AssetTypeDatabricksSeed) || s.WillRunTaskOfType(pipeline.AssetTypeDatabricksQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeDatabricksTableSensor) {
+		s.WillRunTaskOfType(pipeline.AssetTypeDatabricksSeed) || s.WillRunTaskOfType(pipeline.AssetTypeDatabricksQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeDatabricksTableSensor) ||
+		s.WillRunTaskOfType(pipeline.AssetTypeDatabricksSource) {
 		databricksOperator := databricks.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializerList{
 			Mat: databricks.NewMaterializer(fullRefresh),
 		}, parser)
@@ -2016,23 +2357,32 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeDatabricksQuery][scheduler.TaskInstanceTypeMain] = databricksOperator
 		mainExecutors[pipeline.AssetTypeDatabricksQuery][scheduler.TaskInstanceTypeColumnCheck] = databricksCheckRunner
 		mainExecutors[pipeline.AssetTypeDatabricksQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDatabricksQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeDatabricksSource][scheduler.TaskInstanceTypeColumnCheck] = databricksCheckRunner
+		mainExecutors[pipeline.AssetTypeDatabricksSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDatabricksSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeDatabricksSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeDatabricksSeed][scheduler.TaskInstanceTypeColumnCheck] = databricksCheckRunner
 		mainExecutors[pipeline.AssetTypeDatabricksSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDatabricksSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeDatabricksQuerySensor][scheduler.TaskInstanceTypeMain] = databricksQuerySensor
 		mainExecutors[pipeline.AssetTypeDatabricksQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = databricksCheckRunner
 		mainExecutors[pipeline.AssetTypeDatabricksQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDatabricksQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeDatabricksTableSensor][scheduler.TaskInstanceTypeMain] = databricksTableSensor
 		mainExecutors[pipeline.AssetTypeDatabricksQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = databricksCheckRunner
 		mainExecutors[pipeline.AssetTypeDatabricksQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDatabricksQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		// we set the Python runners to run the checks on MsSQL
 		if estimateCustomCheckType == pipeline.AssetTypeDatabricksQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = databricksOperator
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 
@@ -2047,37 +2397,53 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeIngestr][scheduler.TaskInstanceTypeMain] = ingestrOperator
 		mainExecutors[pipeline.AssetTypeIngestr][scheduler.TaskInstanceTypeColumnCheck] = ingestrCheckRunner
 		mainExecutors[pipeline.AssetTypeIngestr][scheduler.TaskInstanceTypeCustomCheck] = ingestrCustomCheckRunner
+		mainExecutors[pipeline.AssetTypeIngestr][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 	}
 
 	//nolint:dupl
-	if s.WillRunTaskOfType(pipeline.AssetTypeAthenaQuery) || estimateCustomCheckType == pipeline.AssetTypeAthenaQuery || s.WillRunTaskOfType(pipeline.AssetTypeAthenaSeed) {
+	if s.WillRunTaskOfType(pipeline.AssetTypeAthenaQuery) || estimateCustomCheckType == pipeline.AssetTypeAthenaQuery || s.WillRunTaskOfType(pipeline.AssetTypeAthenaSeed) || s.WillRunTaskOfType(pipeline.AssetTypeAthenaSource) ||
+		s.WillRunTaskOfType(pipeline.AssetTypeAthenaSQLSensor) || s.WillRunTaskOfType(pipeline.AssetTypeAthenaTableSensor) {
 		athenaOperator := athena.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializerListWithLocation{
 			Mat: athena.NewMaterializer(fullRefresh),
 		}, parser)
 		athenaCheckRunner := athena.NewColumnCheckOperator(conn)
+		athenaQuerySensor := athena.NewQuerySensor(conn, wholeFileExtractor, sensorMode)
 		athenaTableSensor := ansisql.NewTableSensor(conn, sensorMode, wholeFileExtractor)
 
 		mainExecutors[pipeline.AssetTypeAthenaQuery][scheduler.TaskInstanceTypeMain] = athenaOperator
 		mainExecutors[pipeline.AssetTypeAthenaQuery][scheduler.TaskInstanceTypeColumnCheck] = athenaCheckRunner
 		mainExecutors[pipeline.AssetTypeAthenaQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeAthenaQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeAthenaSource][scheduler.TaskInstanceTypeColumnCheck] = athenaCheckRunner
+		mainExecutors[pipeline.AssetTypeAthenaSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeAthenaSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeAthenaSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeAthenaSeed][scheduler.TaskInstanceTypeColumnCheck] = athenaCheckRunner
 		mainExecutors[pipeline.AssetTypeAthenaSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeAthenaSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeAthenaSQLSensor][scheduler.TaskInstanceTypeMain] = athenaQuerySensor
+		mainExecutors[pipeline.AssetTypeAthenaSQLSensor][scheduler.TaskInstanceTypeColumnCheck] = athenaCheckRunner
+		mainExecutors[pipeline.AssetTypeAthenaSQLSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeAthenaSQLSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeAthenaTableSensor][scheduler.TaskInstanceTypeMain] = athenaTableSensor
 		mainExecutors[pipeline.AssetTypeAthenaTableSensor][scheduler.TaskInstanceTypeColumnCheck] = athenaCheckRunner
 		mainExecutors[pipeline.AssetTypeAthenaTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeAthenaTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		if estimateCustomCheckType == pipeline.AssetTypeAthenaQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = athenaCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 
 	//nolint:dupl
 	if s.WillRunTaskOfType(pipeline.AssetTypeDuckDBQuery) || estimateCustomCheckType == pipeline.AssetTypeDuckDBQuery ||
-		s.WillRunTaskOfType(pipeline.AssetTypeDuckDBSeed) || s.WillRunTaskOfType(pipeline.AssetTypeDuckDBQuerySensor) {
+		s.WillRunTaskOfType(pipeline.AssetTypeDuckDBSeed) || s.WillRunTaskOfType(pipeline.AssetTypeDuckDBQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeDuckDBSource) {
 		duckDBOperator := duck.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializer{
 			Mat: duck.NewMaterializer(fullRefresh),
 		}, parser)
@@ -2087,24 +2453,33 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeDuckDBQuery][scheduler.TaskInstanceTypeMain] = duckDBOperator
 		mainExecutors[pipeline.AssetTypeDuckDBQuery][scheduler.TaskInstanceTypeColumnCheck] = duckDBCheckRunner
 		mainExecutors[pipeline.AssetTypeDuckDBQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDuckDBQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeDuckDBSource][scheduler.TaskInstanceTypeColumnCheck] = duckDBCheckRunner
+		mainExecutors[pipeline.AssetTypeDuckDBSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDuckDBSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeDuckDBSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeDuckDBSeed][scheduler.TaskInstanceTypeColumnCheck] = duckDBCheckRunner
 		mainExecutors[pipeline.AssetTypeDuckDBSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDuckDBSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeDuckDBQuerySensor][scheduler.TaskInstanceTypeMain] = duckDBQuerySensor
 		mainExecutors[pipeline.AssetTypeDuckDBQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = duckDBCheckRunner
 		mainExecutors[pipeline.AssetTypeDuckDBQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeDuckDBQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		if estimateCustomCheckType == pipeline.AssetTypeDuckDBQuery {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = duckDBCheckRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 
 	//nolint:dupl
 	if s.WillRunTaskOfType(pipeline.AssetTypeClickHouse) || estimateCustomCheckType == pipeline.AssetTypeClickHouse ||
-		s.WillRunTaskOfType(pipeline.AssetTypeClickHouseSeed) || s.WillRunTaskOfType(pipeline.AssetTypeClickHouseQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeClickHouseTableSensor) {
+		s.WillRunTaskOfType(pipeline.AssetTypeClickHouseSeed) || s.WillRunTaskOfType(pipeline.AssetTypeClickHouseQuerySensor) || s.WillRunTaskOfType(pipeline.AssetTypeClickHouseTableSensor) ||
+		s.WillRunTaskOfType(pipeline.AssetTypeClickHouseSource) {
 		clickHouseOperator := clickhouse.NewBasicOperator(conn, wholeFileExtractor, pipeline.HookWrapperMaterializerList{
 			Mat: clickhouse.NewMaterializer(fullRefresh),
 		}, parser)
@@ -2115,22 +2490,31 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeClickHouse][scheduler.TaskInstanceTypeMain] = clickHouseOperator
 		mainExecutors[pipeline.AssetTypeClickHouse][scheduler.TaskInstanceTypeColumnCheck] = checkRunner
 		mainExecutors[pipeline.AssetTypeClickHouse][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeClickHouse][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
+
+		mainExecutors[pipeline.AssetTypeClickHouseSource][scheduler.TaskInstanceTypeColumnCheck] = checkRunner
+		mainExecutors[pipeline.AssetTypeClickHouseSource][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeClickHouseSource][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeClickHouseSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeClickHouseSeed][scheduler.TaskInstanceTypeColumnCheck] = checkRunner
 		mainExecutors[pipeline.AssetTypeClickHouseSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeClickHouseSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeClickHouseQuerySensor][scheduler.TaskInstanceTypeMain] = clickHouseQuerySensor
 		mainExecutors[pipeline.AssetTypeClickHouseQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = checkRunner
 		mainExecutors[pipeline.AssetTypeClickHouseQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeClickHouseQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeClickHouseTableSensor][scheduler.TaskInstanceTypeMain] = clickHouseTableSensor
 		mainExecutors[pipeline.AssetTypeClickHouseTableSensor][scheduler.TaskInstanceTypeColumnCheck] = checkRunner
 		mainExecutors[pipeline.AssetTypeClickHouseTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeClickHouseTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		if estimateCustomCheckType == pipeline.AssetTypeClickHouse {
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeColumnCheck] = checkRunner
 			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+			mainExecutors[pipeline.AssetTypePython][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 		}
 	}
 
@@ -2155,6 +2539,18 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeQuicksightDashboard][scheduler.TaskInstanceTypeMain] = qsOperator
 	}
 
+	if s.WillRunTaskOfType(pipeline.AssetTypeLooker) {
+		mainExecutors[pipeline.AssetTypeLooker][scheduler.TaskInstanceTypeMain] = looker.NewBasicOperator(conn)
+	}
+
+	if s.WillRunTaskOfType(pipeline.AssetTypePowerBI) {
+		mainExecutors[pipeline.AssetTypePowerBI][scheduler.TaskInstanceTypeMain] = powerbi.NewBasicOperator(conn)
+	}
+
+	if s.WillRunTaskOfType(pipeline.AssetTypeMetabase) {
+		mainExecutors[pipeline.AssetTypeMetabase][scheduler.TaskInstanceTypeMain] = metabase.NewBasicOperator(conn)
+	}
+
 	emrServerlessAssetTypes := []pipeline.AssetType{
 		pipeline.AssetTypeEMRServerlessSpark,
 		pipeline.AssetTypeEMRServerlessPyspark,
@@ -2202,18 +2598,22 @@ func SetupExecutors(
 		mainExecutors[pipeline.AssetTypeMySQLQuery][scheduler.TaskInstanceTypeMain] = mysqlOperator
 		mainExecutors[pipeline.AssetTypeMySQLQuery][scheduler.TaskInstanceTypeColumnCheck] = mysqlCheckRunner
 		mainExecutors[pipeline.AssetTypeMySQLQuery][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMySQLQuery][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeMySQLSeed][scheduler.TaskInstanceTypeMain] = seedOperator
 		mainExecutors[pipeline.AssetTypeMySQLSeed][scheduler.TaskInstanceTypeColumnCheck] = mysqlCheckRunner
 		mainExecutors[pipeline.AssetTypeMySQLSeed][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMySQLSeed][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeMySQLQuerySensor][scheduler.TaskInstanceTypeMain] = mysqlQuerySensor
 		mainExecutors[pipeline.AssetTypeMySQLQuerySensor][scheduler.TaskInstanceTypeColumnCheck] = mysqlCheckRunner
 		mainExecutors[pipeline.AssetTypeMySQLQuerySensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMySQLQuerySensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 
 		mainExecutors[pipeline.AssetTypeMySQLTableSensor][scheduler.TaskInstanceTypeMain] = mysqlTableSensor
 		mainExecutors[pipeline.AssetTypeMySQLTableSensor][scheduler.TaskInstanceTypeColumnCheck] = mysqlCheckRunner
 		mainExecutors[pipeline.AssetTypeMySQLTableSensor][scheduler.TaskInstanceTypeCustomCheck] = customCheckRunner
+		mainExecutors[pipeline.AssetTypeMySQLTableSensor][scheduler.TaskInstanceTypeTableCheck] = tableCheckRunner
 	}
 
 	if s.WillRunTaskOfType(pipeline.AssetTypeAgentClaudeCode) {
@@ -2552,6 +2952,7 @@ func FilterTaskTypes(ctx context.Context, f *Filter, s *scheduler.Scheduler, p *
 		if !runChecks {
 			s.MarkPendingInstancesByType(scheduler.TaskInstanceTypeColumnCheck, scheduler.Skipped)
 			s.MarkPendingInstancesByType(scheduler.TaskInstanceTypeCustomCheck, scheduler.Skipped)
+			s.MarkPendingInstancesByType(scheduler.TaskInstanceTypeTableCheck, scheduler.Skipped)
 		}
 		if !runPushMetadata {
 			s.MarkPendingInstancesByType(scheduler.TaskInstanceTypeMetadataPush, scheduler.Skipped)
@@ -1583,6 +1583,7 @@ func TestAnalyzeResults(t *testing.T) {
 				},
 			},
 			CustomChecks: []pipeline.CustomCheck{{Name: "row_count"}},
+			TableChecks:  []pipeline.TableCheck{{Name: pipeline.TableCheckRowCount}},
 		}
 	}
 
@@ -1594,6 +1595,8 @@ func TestAnalyzeResults(t *testing.T) {
 		columnSucceeded int
 		customTotal     int
 		customSucceeded int
+		tableTotal      int
+		tableSucceeded  int
 	}
 
 	tests := []struct {
@@ -1612,6 +1615,8 @@ func TestAnalyzeResults(t *testing.T) {
 				columnSucceeded: 1,
 				customTotal:     1,
 				customSucceeded: 1,
+				tableTotal:      1,
+				tableSucceeded:  1,
 			},
 		},
 	}
@@ -1645,6 +1650,8 @@ func TestAnalyzeResults(t *testing.T) {
 			require.Equal(t, tt.expected.columnSucceeded, summary.ColumnChecks.Succeeded)
 			require.Equal(t, tt.expected.customTotal, summary.CustomChecks.Total)
 			require.Equal(t, tt.expected.customSucceeded, summary.CustomChecks.Succeeded)
+			require.Equal(t, tt.expected.tableTotal, summary.TableChecks.Total)
+			require.Equal(t, tt.expected.tableSucceeded, summary.TableChecks.Succeeded)
 		})
 	}
 }
@@ -1703,6 +1710,7 @@ func TestAnalyzeResults_MultiAssetWithUpstreamFailure(t *testing.T) {
 		wantColumn  TaskTypeStats
 		wantCustom  TaskTypeStats
 		wantSkipped int
+		wantWarned  int
 	}{
 		{
 			name: "all succeed",
@@ -1793,6 +1801,26 @@ func TestAnalyzeResults_MultiAssetWithUpstreamFailure(t *testing.T) {
 			wantColumn: TaskTypeStats{Total: 2, Succeeded: 1, Failed: 1},
 			wantCustom: TaskTypeStats{Total: 1, Succeeded: 1},
 		},
+		{
+			name: "B's column check warns - everything succeeds",
+			setup: func(s *scheduler.Scheduler) []*scheduler.TaskExecutionResult {
+				pending := s.GetTaskInstancesByStatus(scheduler.Pending)
+				results := make([]*scheduler.TaskExecutionResult, 0, len(pending))
+				for _, inst := range pending {
+					if check, ok := inst.(*scheduler.ColumnCheckInstance); ok && inst.GetAsset().Name == "assetB" {
+						check.Warning = errors.New("check failed")
+					}
+
+					s.MarkTaskInstance(inst, scheduler.Succeeded, false)
+					results = append(results, &scheduler.TaskExecutionResult{Instance: inst})
+				}
+				return results
+			},
+			wantAssets: TaskTypeStats{Total: 3, Succeeded: 3},
+			wantColumn: TaskTypeStats{Total: 2, Succeeded: 2, Warned: 1},
+			wantCustom: TaskTypeStats{Total: 1, Succeeded: 1},
+			wantWarned: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1813,11 +1841,13 @@ func TestAnalyzeResults_MultiAssetWithUpstreamFailure(t *testing.T) {
 			assert.Equal(t, tt.wantColumn.Succeeded, summary.ColumnChecks.Succeeded, "column checks succeeded")
 			assert.Equal(t, tt.wantColumn.Failed, summary.ColumnChecks.Failed, "column checks failed")
 			assert.Equal(t, tt.wantColumn.Skipped, summary.ColumnChecks.Skipped, "column checks skipped")
+			assert.Equal(t, tt.wantColumn.Warned, summary.ColumnChecks.Warned, "column checks warned")
 
 			assert.Equal(t, tt.wantCustom.Total, summary.CustomChecks.Total, "custom checks total")
 			assert.Equal(t, tt.wantCustom.Succeeded, summary.CustomChecks.Succeeded, "custom checks succeeded")
 			assert.Equal(t, tt.wantCustom.Failed, summary.CustomChecks.Failed, "custom checks failed")
 			assert.Equal(t, tt.wantCustom.Skipped, summary.CustomChecks.Skipped, "custom checks skipped")
+			assert.Equal(t, tt.wantWarned, summary.WarnedTasks, "warned tasks")
 		})
 	}
 }
@@ -0,0 +1,256 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/git"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/runhistory"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/urfave/cli/v3"
+)
+
+const maxErrorLengthInTable = 80
+
+func runHistoryPath(repoRoot string) string {
+	return filepath.Join(repoRoot, LogsFolder, "runs", "history.jsonl")
+}
+
+// newRunRecord builds the record of a finished run, it is what the run history stores and what `bruin run` reports
+// with --output json and --junit-report.
+func newRunRecord(p *pipeline.Pipeline, runConfig *scheduler.RunConfig, runID, logPath string, s *scheduler.Scheduler, results []*scheduler.TaskExecutionResult, start time.Time) *runhistory.Run {
+	run := runhistory.NewRun(p, runID, s.GetTaskInstances(), results, start, time.Now())
+	run.Cmdline = os.Args
+	run.Parameters = *runConfig
+	run.LogPath = logPath
+
+	return run
+}
+
+// recordRunHistory appends the outcome of a finished run to the local run history of the repository.
+func recordRunHistory(fs afero.Fs, repoRoot string, run *runhistory.Run) error {
+	return runhistory.NewStore(fs, runHistoryPath(repoRoot)).Record(run)
+}
+
+// writeRunResults prints the run as JSON when requested and writes its JUnit report to the given file, if any.
+func writeRunResults(fs afero.Fs, run *runhistory.Run, output string, junitReportPath string, stdout io.Writer) error {
+	if output == "json" {
+		data, err := json.MarshalIndent(run, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "failed to serialize the run results")
+		}
+		if _, err := fmt.Fprintln(stdout, string(data)); err != nil {
+			return errors.Wrap(err, "failed to print the run results")
+		}
+	}
+
+	if junitReportPath == "" {
+		return nil
+	}
+
+	report, err := run.JUnitReport()
+	if err != nil {
+		return errors.Wrap(err, "failed to render the JUnit report")
+	}
+
+	if err := fs.MkdirAll(filepath.Dir(junitReportPath), 0o755); err != nil {
+		return errors.Wrap(err, "failed to create the directory of the JUnit report")
+	}
+
+	return errors.Wrap(afero.WriteFile(fs, junitReportPath, report, 0o644), "failed to write the JUnit report")
+}
+
+func Runs() *cli.Command {
+	return &cli.Command{
+		Name:  "runs",
+		Usage: "inspect the history of the pipeline runs executed locally",
+		Commands: []*cli.Command{
+			runsList(),
+			runsShow(),
+		},
+	}
+}
+
+func runsList() *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "list the previous runs, the most recent one first",
+		Flags: []cli.Flag{
+			outputFlag(),
+			limitFlag(),
+			&cli.StringFlag{
+				Name:  "pipeline",
+				Usage: "only list the runs of the given pipeline",
+			},
+			&cli.StringFlag{
+				Name:  "asset",
+				Usage: "only list the runs that executed the given asset, the status and duration shown are the asset's",
+			},
+			&cli.StringFlag{
+				Name:  "status",
+				Usage: "filter by status, e.g. succeeded or failed. When --asset is given, the asset's status is matched",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			defer RecoverFromPanic()
+			output := c.String("output")
+
+			store, err := newRunHistoryStore()
+			if err != nil {
+				printError(err, output, "Failed to find the run history")
+				return cli.Exit("", 1)
+			}
+
+			asset := c.String("asset")
+			runs, err := store.List(runhistory.Filter{
+				Pipeline: c.String("pipeline"),
+				Asset:    asset,
+				Status:   c.String("status"),
+				Limit:    int(c.Int("limit")),
+			})
+			if err != nil {
+				printError(err, output, "Failed to read the run history")
+				return cli.Exit("", 1)
+			}
+
+			if output == "json" {
+				data, _ := json.MarshalIndent(runs, "", "  ")
+				fmt.Println(string(data))
+				return nil
+			}
+
+			if len(runs) == 0 {
+				infoPrinter.Println("No runs found.")
+				return nil
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Run ID", "Pipeline", "Status", "Start Date", "Duration", "Failed Tasks"})
+			for _, run := range runs {
+				status, duration := run.Status, run.Duration
+				if task := run.MainTask(asset); task != nil {
+					status, duration = task.Status, task.Duration
+				}
+
+				t.AppendRow(table.Row{run.RunID, run.Pipeline, status, run.StartedAt.Local().Format(time.DateTime), duration, countFailedTasks(run)})
+			}
+			t.Render()
+			return nil
+		},
+	}
+}
+
+func runsShow() *cli.Command {
+	return &cli.Command{
+		Name:      "show",
+		Usage:     "show the parameters and the task results of a run",
+		ArgsUsage: "[run ID]",
+		Flags: []cli.Flag{
+			outputFlag(),
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			defer RecoverFromPanic()
+			output := c.String("output")
+
+			runID := c.Args().Get(0)
+			if runID == "" {
+				printError(errors.New("a run ID is required, use 'bruin runs list' to find one"), output, "Missing run ID")
+				return cli.Exit("", 1)
+			}
+
+			store, err := newRunHistoryStore()
+			if err != nil {
+				printError(err, output, "Failed to find the run history")
+				return cli.Exit("", 1)
+			}
+
+			run, err := store.Get(runID)
+			if err != nil {
+				printError(err, output, "Failed to find the run")
+				return cli.Exit("", 1)
+			}
+
+			if output == "json" {
+				data, _ := json.MarshalIndent(run, "", "  ")
+				fmt.Println(string(data))
+				return nil
+			}
+
+			printRun(run)
+			return nil
+		},
+	}
+}
+
+func newRunHistoryStore() (*runhistory.Store, error) {
+	repoRoot, err := git.FindRepoFromPath(".")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find the git repository root")
+	}
+
+	return runhistory.NewStore(afero.NewOsFs(), runHistoryPath(repoRoot.Path)), nil
+}
+
+func printRun(run *runhistory.Run) {
+	infoPrinter.Println("=== Run ===")
+	fmt.Printf("  Run ID:    %s\n", run.RunID)
+	fmt.Printf("  Pipeline:  %s\n", run.Pipeline)
+	fmt.Printf("  Status:    %s\n", run.Status)
+	fmt.Printf("  Start:     %s\n", run.StartedAt.Local().Format(time.DateTime))
+	fmt.Printf("  End:       %s\n", run.FinishedAt.Local().Format(time.DateTime))
+	fmt.Printf("  Duration:  %s\n", run.Duration)
+	fmt.Printf("  Interval:  %s - %s\n", run.Parameters.StartDate, run.Parameters.EndDate)
+	if run.Parameters.Environment != "" {
+		fmt.Printf("  Env:       %s\n", run.Parameters.Environment)
+	}
+	fmt.Printf("  Command:   %s\n", strings.Join(run.Cmdline, " "))
+	if run.LogPath != "" {
+		fmt.Printf("  Log file:  %s\n", run.LogPath)
+	}
+	fmt.Println()
+
+	infoPrinter.Printf("=== Tasks (%d total, %d failed) ===\n", len(run.Tasks), countFailedTasks(run))
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"Task", "Status", "Duration", "Error"})
+	for _, task := range run.Tasks {
+		duration := ""
+		if task.StartedAt != nil {
+			duration = task.Duration.String()
+		}
+
+		t.AppendRow(table.Row{task.ID, task.Status, duration, truncateTableCell(task.Error)})
+	}
+	t.Render()
+}
+
+func countFailedTasks(run *runhistory.Run) int {
+	failed := 0
+	for _, task := range run.Tasks {
+		if task.Status == scheduler.Failed.String() {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+func truncateTableCell(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if len(text) <= maxErrorLengthInTable {
+		return text
+	}
+
+	return text[:maxErrorLengthInTable-3] + "..."
+}
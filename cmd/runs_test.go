@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/runhistory"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRecordRunHistory(t *testing.T) {
+	t.Parallel()
+
+	p := &pipeline.Pipeline{
+		Name:   "daily",
+		Assets: []*pipeline.Asset{{Name: "orders"}},
+	}
+	s := scheduler.NewScheduler(zap.NewNop().Sugar(), p, "run-1")
+	instance := s.GetTaskInstances()[0]
+	s.MarkTaskInstance(instance, scheduler.Failed, false)
+
+	start := time.Now().Add(-time.Minute)
+	results := []*scheduler.TaskExecutionResult{
+		{Instance: instance, Error: errors.New("boom"), StartedAt: start, FinishedAt: start.Add(time.Second)},
+	}
+
+	fs := afero.NewMemMapFs()
+	runConfig := &scheduler.RunConfig{StartDate: "2024-01-01", EndDate: "2024-01-02"}
+	run := newRunRecord(p, runConfig, "run-1", "/repo/logs/run-1.log", s, results, start)
+	require.NoError(t, recordRunHistory(fs, "/repo", run))
+
+	run, err := runhistory.NewStore(fs, "/repo/logs/runs/history.jsonl").Get("run-1")
+	require.NoError(t, err)
+	assert.Equal(t, runhistory.StatusFailed, run.Status)
+	assert.Equal(t, "/repo/logs/run-1.log", run.LogPath)
+	assert.Equal(t, "2024-01-01", run.Parameters.StartDate)
+	assert.Equal(t, 1, countFailedTasks(run))
+	assert.Equal(t, "boom", run.MainTask("orders").Error)
+}
+
+func TestWriteRunResults(t *testing.T) {
+	t.Parallel()
+
+	run := &runhistory.Run{
+		RunID:    "run-1",
+		Pipeline: "daily",
+		Status:   runhistory.StatusFailed,
+		Tasks: []*runhistory.Task{
+			{ID: "orders", Asset: "orders", Type: "main", Status: "succeeded"},
+			{ID: "orders:id:not_null", Asset: "orders", Type: "column_test", Status: "failed", Error: "1 null value", Check: &runhistory.Check{Name: "not_null", Column: "id", Blocking: true}},
+		},
+	}
+
+	fs := afero.NewMemMapFs()
+	var stdout bytes.Buffer
+	require.NoError(t, writeRunResults(fs, run, "json", "reports/junit.xml", &stdout))
+
+	var printed runhistory.Run
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &printed))
+	assert.Equal(t, "run-1", printed.RunID)
+	require.Len(t, printed.Tasks, 2)
+	assert.Equal(t, "id", printed.Tasks[1].Check.Column)
+
+	report, err := afero.ReadFile(fs, "reports/junit.xml")
+	require.NoError(t, err)
+	assert.Contains(t, string(report), `<testcase name="orders:id:not_null" classname="daily.orders"`)
+
+	stdout.Reset()
+	require.NoError(t, writeRunResults(fs, run, "plain", "", &stdout))
+	assert.Empty(t, stdout.String())
+}
+
+func TestTruncateTableCell(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "line one line two", truncateTableCell("line one\n  line two"))
+
+	truncated := truncateTableCell(strings.Repeat("a", 100))
+	assert.Len(t, truncated, maxErrorLengthInTable)
+	assert.True(t, strings.HasSuffix(truncated, "..."))
+}
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/date"
+	"github.com/bruin-data/bruin/pkg/jinja"
+	"github.com/bruin-data/bruin/pkg/path"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/bruin-data/bruin/pkg/sqlparser"
+	"github.com/bruin-data/bruin/pkg/unittest"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v3"
+)
+
+func Test() *cli.Command {
+	return &cli.Command{
+		Name:                      "test",
+		Usage:                     "run the unit tests of SQL assets against their fixtures on a local DuckDB database",
+		ArgsUsage:                 "[path to the asset definition or the pipeline]",
+		DisableSliceFlagSeparator: true,
+		Flags: []cli.Flag{
+			startDateFlag,
+			endDateFlag,
+			&cli.StringSliceFlag{
+				Name:  "var",
+				Usage: "override pipeline variables with custom values",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			inputPath := c.Args().Get(0)
+			if inputPath == "" {
+				errorPrinter.Printf("Please give an asset or a pipeline path to test: bruin test <path to the asset file or the pipeline>\n")
+				return cli.Exit("", 1)
+			}
+			if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+				errorPrinter.Printf("The specified path does not exist: %s\n", inputPath)
+				return cli.Exit("", 1)
+			}
+
+			if vars := c.StringSlice("var"); len(vars) > 0 {
+				DefaultPipelineBuilder.AddPipelineMutator(variableOverridesMutator(vars))
+			}
+
+			startDate, err := date.ParseTime(c.String("start-date"))
+			if err != nil {
+				errorPrinter.Printf("Please give a valid start date: bruin test --start-date <start date>\n")
+				return cli.Exit("", 1)
+			}
+			endDate, err := date.ParseTime(c.String("end-date"))
+			if err != nil {
+				errorPrinter.Printf("Please give a valid end date: bruin test --end-date <end date>\n")
+				return cli.Exit("", 1)
+			}
+
+			pipelinePath := inputPath
+			runningForAnAsset := isPathReferencingAsset(inputPath)
+			if runningForAnAsset {
+				pipelinePath, err = path.GetPipelineRootFromTask(inputPath, PipelineDefinitionFiles)
+				if err != nil {
+					errorPrinter.Printf("Failed to find the pipeline this asset belongs to: '%s'\n", inputPath)
+					return cli.Exit("", 1)
+				}
+			}
+
+			pl, err := DefaultPipelineBuilder.CreatePipelineFromPath(ctx, pipelinePath, pipeline.WithMutate())
+			if err != nil {
+				printError(err, "", "Failed to build the pipeline")
+				return cli.Exit("", 1)
+			}
+
+			assets := pl.Assets
+			if runningForAnAsset {
+				asset := pl.GetAssetByPath(inputPath)
+				if asset == nil {
+					errorPrinter.Printf("The given file path doesn't seem to be a Bruin asset definition: '%s'\n", inputPath)
+					return cli.Exit("", 1)
+				}
+				assets = []*pipeline.Asset{asset}
+			}
+
+			assets = assetsWithUnitTests(assets)
+			if len(assets) == 0 {
+				infoPrinter.Println("No unit tests found.")
+				return nil
+			}
+
+			macroContent, err := jinja.LoadMacros(fs, pl.MacrosPath)
+			if err != nil {
+				printError(err, "", "Failed to load macros")
+				return cli.Exit("", 1)
+			}
+
+			parser, err := sqlparser.NewSQLParser(false)
+			if err != nil {
+				printError(err, "", "Failed to initialize the SQL parser")
+				return cli.Exit("", 1)
+			}
+			defer parser.Close()
+			if err := parser.Start(); err != nil {
+				printError(err, "", "Failed to start the SQL parser")
+				return cli.Exit("", 1)
+			}
+
+			runCtx := context.WithValue(ctx, pipeline.RunConfigRunID, "your-run-id")
+			runCtx = context.WithValue(runCtx, pipeline.RunConfigStartDate, startDate)
+			runCtx = context.WithValue(runCtx, pipeline.RunConfigEndDate, endDate)
+			runCtx = context.WithValue(runCtx, pipeline.RunConfigExecutionDate, defaultExecutionDate)
+
+			renderer := jinja.NewRendererWithStartEndDatesAndMacros(&startDate, &endDate, &defaultExecutionDate, pl.Name, "your-run-id", pl.Variables.Value(), macroContent)
+			runner := unittest.NewRunner(parser)
+
+			var results []*unittest.Result
+			for _, asset := range assets {
+				renderedQuery, err := renderUnitTestQuery(runCtx, pl, asset, renderer)
+				if err != nil {
+					for _, test := range asset.Tests {
+						results = append(results, &unittest.Result{Asset: asset.Name, Test: test.Name, Err: err})
+					}
+					continue
+				}
+
+				results = append(results, runner.RunAsset(runCtx, asset, renderedQuery)...)
+			}
+
+			if failed := printUnitTestResults(os.Stdout, results); failed > 0 {
+				return cli.Exit("", 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func assetsWithUnitTests(assets []*pipeline.Asset) []*pipeline.Asset {
+	var found []*pipeline.Asset
+	for _, asset := range assets {
+		if len(asset.Tests) > 0 {
+			found = append(found, asset)
+		}
+	}
+
+	return found
+}
+
+// renderUnitTestQuery renders the query of the asset the same way a run would, without the materialization around it.
+func renderUnitTestQuery(ctx context.Context, pl *pipeline.Pipeline, asset *pipeline.Asset, renderer *jinja.Renderer) (string, error) {
+	forAsset, err := renderer.CloneForAsset(ctx, pl, asset)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the renderer for the asset")
+	}
+
+	extractor := &query.WholeFileExtractor{Fs: fs, Renderer: forAsset}
+	queries, err := extractor.ExtractQueriesFromString(asset.ExecutableFile.Content)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to render the query of the asset")
+	}
+	if len(queries) != 1 {
+		return "", errors.Errorf("unit tests require the asset to have a single query, found %d", len(queries))
+	}
+
+	return queries[0].Query, nil
+}
+
+// printUnitTestResults prints a line per test and the rows that did not match for the failed ones, it returns the
+// number of the tests that did not pass.
+func printUnitTestResults(w io.Writer, results []*unittest.Result) int {
+	failed := 0
+	for _, result := range results {
+		name := fmt.Sprintf("%s: %s", result.Asset, result.Test)
+		if result.Passed() {
+			successPrinter.Fprintf(w, "PASS %s\n", name)
+			continue
+		}
+
+		failed++
+		errorPrinter.Fprintf(w, "FAIL %s\n", name)
+		if result.Err != nil {
+			fmt.Fprintf(w, "    %s\n", result.Err)
+			continue
+		}
+
+		printUnitTestRows(w, "Expected rows missing from the output:", result.Columns, result.MissingRows)
+		printUnitTestRows(w, "Unexpected rows in the output:", result.Columns, result.UnexpectedRows)
+	}
+
+	summary := fmt.Sprintf("\n%d unit tests, %d passed, %d failed\n", len(results), len(results)-failed, failed)
+	if failed > 0 {
+		errorPrinter.Fprint(w, summary)
+	} else {
+		successPrinter.Fprint(w, summary)
+	}
+
+	return failed
+}
+
+func printUnitTestRows(w io.Writer, title string, columns []string, rows [][]any) {
+	if len(rows) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "    %s\n", title)
+	t := table.NewWriter()
+	header := make(table.Row, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+	t.AppendHeader(header)
+	for _, row := range rows {
+		t.AppendRow(row)
+	}
+	for _, line := range strings.Split(t.Render(), "\n") {
+		fmt.Fprintf(w, "    %s\n", line)
+	}
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/unittest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetsWithUnitTests(t *testing.T) {
+	t.Parallel()
+
+	withTests := &pipeline.Asset{Name: "with", Tests: []pipeline.UnitTest{{Name: "a"}}}
+	withoutTests := &pipeline.Asset{Name: "without"}
+
+	assert.Equal(t, []*pipeline.Asset{withTests}, assetsWithUnitTests([]*pipeline.Asset{withoutTests, withTests}))
+	assert.Empty(t, assetsWithUnitTests([]*pipeline.Asset{withoutTests}))
+}
+
+func TestPrintUnitTestResults(t *testing.T) {
+	t.Parallel()
+
+	results := []*unittest.Result{
+		{Asset: "analytics.totals", Test: "sums the orders"},
+		{
+			Asset:          "analytics.totals",
+			Test:           "skips refunds",
+			Columns:        []string{"total"},
+			MissingRows:    [][]any{{30.5}},
+			UnexpectedRows: [][]any{{42}},
+		},
+		{Asset: "analytics.users", Test: "dedupes", Err: errors.New("the query reads tables that have no fixture in the test: raw.users")},
+	}
+
+	var buf bytes.Buffer
+	failed := printUnitTestResults(&buf, results)
+	output := buf.String()
+
+	assert.Equal(t, 2, failed)
+	assert.Contains(t, output, "PASS analytics.totals: sums the orders")
+	assert.Contains(t, output, "FAIL analytics.totals: skips refunds")
+	assert.Contains(t, output, "Expected rows missing from the output:")
+	assert.Contains(t, output, "30.5")
+	assert.Contains(t, output, "Unexpected rows in the output:")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "FAIL analytics.users: dedupes")
+	assert.Contains(t, output, "no fixture in the test: raw.users")
+	assert.Contains(t, output, "3 unit tests, 1 passed, 2 failed")
+}
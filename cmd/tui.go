@@ -87,7 +87,7 @@ func (t *TUIRenderer) initFromScheduler(s *scheduler.Scheduler) {
 		case scheduler.TaskInstanceTypeMain:
 			row.status = inst.GetStatus()
 			t.totalMain++
-		case scheduler.TaskInstanceTypeColumnCheck, scheduler.TaskInstanceTypeCustomCheck:
+		case scheduler.TaskInstanceTypeColumnCheck, scheduler.TaskInstanceTypeCustomCheck, scheduler.TaskInstanceTypeTableCheck:
 			row.checks = append(row.checks, &checkRow{
 				name:   inst.GetHumanID(),
 				status: inst.GetStatus(),
@@ -112,7 +112,7 @@ func (t *TUIRenderer) OnStatusChange(event scheduler.StatusChangeEvent) {
 	switch event.Instance.GetType() {
 	case scheduler.TaskInstanceTypeMain:
 		row.status = event.NewStatus
-	case scheduler.TaskInstanceTypeColumnCheck, scheduler.TaskInstanceTypeCustomCheck:
+	case scheduler.TaskInstanceTypeColumnCheck, scheduler.TaskInstanceTypeCustomCheck, scheduler.TaskInstanceTypeTableCheck:
 		humanID := event.Instance.GetHumanID()
 		for _, c := range row.checks {
 			if c.name == humanID {
@@ -60,15 +60,24 @@ func printTUISummary(w io.Writer, results []*scheduler.TaskExecutionResult, s *s
 	}
 
 	// Quality checks
-	totalChecks := summary.ColumnChecks.Total + summary.CustomChecks.Total
-	totalCheckFailures := summary.ColumnChecks.Failed + summary.CustomChecks.Failed
-	totalCheckSkipped := summary.ColumnChecks.Skipped + summary.CustomChecks.Skipped
+	totalChecks := summary.ColumnChecks.Total + summary.CustomChecks.Total + summary.TableChecks.Total
+	totalCheckFailures := summary.ColumnChecks.Failed + summary.CustomChecks.Failed + summary.TableChecks.Failed
+	totalCheckSkipped := summary.ColumnChecks.Skipped + summary.CustomChecks.Skipped + summary.TableChecks.Skipped
+	totalCheckWarnings := summary.ColumnChecks.Warned + summary.CustomChecks.Warned + summary.TableChecks.Warned
 	if totalChecks > 0 {
 		fmt.Fprintln(w)
-		if totalCheckFailures > 0 || totalCheckSkipped > 0 {
+		if totalCheckFailures > 0 || totalCheckSkipped > 0 { // nolint:gocritic
+			counts := formatCountWithSkipped(totalChecks, totalCheckFailures, 0, totalCheckSkipped)
+			if totalCheckWarnings > 0 {
+				counts += " / " + color.New(color.FgYellow).Sprintf("%d warned", totalCheckWarnings)
+			}
 			fmt.Fprintf(w, "  %s Quality checks       %s\n",
-				color.New(color.FgRed).Sprint("✗"),
-				formatCountWithSkipped(totalChecks, totalCheckFailures, 0, totalCheckSkipped))
+				color.New(color.FgRed).Sprint("✗"), counts)
+		} else if totalCheckWarnings > 0 {
+			fmt.Fprintf(w, "  %s Quality checks       %s / %s\n",
+				color.New(color.FgYellow).Sprint("!"),
+				color.New(color.FgYellow).Sprintf("%d warned", totalCheckWarnings),
+				color.New(color.FgGreen).Sprintf("%d succeeded", totalChecks-totalCheckWarnings))
 		} else {
 			fmt.Fprintf(w, "  %s Quality checks       %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
@@ -92,9 +101,12 @@ func printTUISummary(w io.Writer, results []*scheduler.TaskExecutionResult, s *s
 	fmt.Fprintf(w, "\n%s\n", dimText(separator))
 
 	// Overall status
-	if hasFailures {
+	if hasFailures { // nolint:gocritic
 		fmt.Fprintf(w, "\n  %s\n\n",
 			color.New(color.FgRed, color.Bold).Sprint("Run completed with failures"))
+	} else if summary.WarnedTasks > 0 {
+		fmt.Fprintf(w, "\n  %s\n\n",
+			color.New(color.FgYellow, color.Bold).Sprint("Run completed with warnings"))
 	} else {
 		fmt.Fprintf(w, "\n  %s\n\n",
 			color.New(color.FgGreen, color.Bold).Sprint("Run completed successfully"))
@@ -128,7 +140,7 @@ func collectAssetResults(results []*scheduler.TaskExecutionResult, s *scheduler.
 			if res.Error != nil {
 				out[idx].failed = true
 			}
-		case *scheduler.ColumnCheckInstance, *scheduler.CustomCheckInstance:
+		case *scheduler.ColumnCheckInstance, *scheduler.CustomCheckInstance, *scheduler.TableCheckInstance:
 			if res.Error != nil {
 				out[idx].checkFailed = true
 			}
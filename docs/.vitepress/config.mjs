@@ -134,6 +134,9 @@ j=d.createElement(s),dl=l!='dataLayer'?'&l='+l:'';j.async=true;j.src=
                             {text: "R", link: "/assets/r"},
                             {text: "Sensor", link: "/assets/sensor"},
                             {text: "Dashboard", link: "/assets/dashboard", items: [
+                                {text: "Looker", link: "/assets/looker-refresh"},
+                                {text: "Metabase", link: "/assets/metabase-refresh"},
+                                {text: "Power BI", link: "/assets/powerbi-refresh"},
                                 {text: "Tableau", link: "/assets/tableau-refresh"},
                             ]},
                         ]
@@ -295,6 +298,7 @@ j=d.createElement(s),dl=l!='dataLayer'?'&l='+l:'';j.async=true;j.src=
                     {text: "Overview", link: "/commands/overview"},
                     {text: "Run", link: "/commands/run"},
                     {text: "Validate", link: "/commands/validate"},
+                    {text: "Runs", link: "/commands/runs"},
                     {text: "Init", link: "/commands/init"},
                     {text: "Clean", link: "/commands/clean"},
                     {text: "Connections", link: "/commands/connections"},
@@ -305,6 +309,7 @@ j=d.createElement(s),dl=l!='dataLayer'?'&l='+l:'';j.async=true;j.src=
                     {text: "Lineage", link: "/commands/lineage"},
                     {text: "Patch", link: "/commands/patch"},
                     {text: "Render", link: "/commands/render"},
+                    {text: "Test", link: "/commands/test"},
                     {text: "Query", link: "/commands/query"},
                     {text: "AI Enhance", link: "/commands/ai-enhance"},
                     {text: "Cloud", link: "/commands/cloud"},
@@ -323,6 +328,7 @@ j=d.createElement(s),dl=l!='dataLayer'?'&l='+l:'';j.async=true;j.src=
                             {text: "Overview", link: "/quality/overview"},
                             {text: "Column Checks", link: "/quality/available_checks"},
                             {text: "Custom Checks", link: "/quality/custom"},
+                            {text: "Table Checks", link: "/quality/table"},
                         ],
                     },
                 ],
@@ -963,6 +963,23 @@ func TestIndividualTasks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "run-source-checks",
+			task: e2e.Task{
+				Name:    "run-source-checks",
+				Command: binary,
+				Args:    []string{"run", "--env", "env-source-checks", filepath.Join(currentFolder, "test-pipelines/source-checks-pipeline")},
+				Env:     []string{},
+				Expected: e2e.Output{
+					ExitCode: 1,
+					Contains: []string{"column 'product_name' has 1 null values"},
+				},
+				Asserts: []func(*e2e.Task) error{
+					e2e.AssertByExitCode,
+					e2e.AssertByContains,
+				},
+			},
+		},
 		{
 			name: "run-custom-check-count-true",
 			task: e2e.Task{
@@ -0,0 +1,9 @@
+/* @bruin
+name: load_products
+type: duckdb.sql
+@bruin */
+
+CREATE OR REPLACE TABLE raw_products AS
+SELECT 1 AS product_id, 'Laptop' AS product_name
+UNION ALL
+SELECT 2 AS product_id, NULL AS product_name;
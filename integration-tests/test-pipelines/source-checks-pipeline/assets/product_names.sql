@@ -0,0 +1,13 @@
+/* @bruin
+name: product_names
+type: duckdb.sql
+
+materialization:
+  type: table
+
+depends:
+  - raw_products
+@bruin */
+
+SELECT product_id, upper(product_name) AS product_name
+FROM raw_products;
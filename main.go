@@ -69,7 +69,9 @@ func main() {
 		Commands: []*cli.Command{
 			cmd.Lint(&isDebug),
 			cmd.Run(&isDebug),
+			cmd.Runs(),
 			cmd.Render(),
+			cmd.Test(),
 			cmd.RenderDDL(),
 			cmd.Lineage(),
 			cmd.CleanCmd(),
@@ -8,6 +8,7 @@ import (
 	"github.com/bruin-data/bruin/pkg/config"
 	"github.com/bruin-data/bruin/pkg/helpers"
 	"github.com/bruin-data/bruin/pkg/jinja"
+	"github.com/bruin-data/bruin/pkg/pipeline"
 	"github.com/bruin-data/bruin/pkg/query"
 	"github.com/bruin-data/bruin/pkg/scheduler"
 	"github.com/pkg/errors"
@@ -24,6 +25,10 @@ type CheckError struct {
 	Result   int64
 	Expected int64
 	Message  string
+	// Severity is either "error" or "warn", the checks that fail with a warning do not fail the run.
+	Severity pipeline.CheckSeverity
+	// FailingRows is a sample of the rows that violate the check, it is only fetched when requested for the run.
+	FailingRows *FailingRows
 }
 
 func (e *CheckError) Error() string {
@@ -36,6 +41,12 @@ type CountableQueryCheck struct {
 	queryInstance       *query.Query
 	checkName           string
 	customError         func(count int64) error
+	// failingRowsQuery selects the rows that violate the check, it is derived from the query when it is a plain count.
+	failingRowsQuery string
+
+	severity pipeline.CheckSeverity
+	warnIf   string
+	errorIf  string
 }
 
 func NewCountableQueryCheck(conn config.ConnectionGetter, expectedQueryResult int64, queryInstance *query.Query, checkName string, customError func(count int64) error) *CountableQueryCheck {
@@ -48,12 +59,25 @@ func NewCountableQueryCheck(conn config.ConnectionGetter, expectedQueryResult in
 	}
 }
 
+// WithFailingRowsQuery sets the query that selects the rows violating the check, for the checks that do not count
+// the violating rows with a plain "SELECT count(*) FROM ... WHERE ..." query.
+func (c *CountableQueryCheck) WithFailingRowsQuery(qq string) *CountableQueryCheck {
+	c.failingRowsQuery = qq
+	return c
+}
+
 func (c *CountableQueryCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInstance) error {
 	conn, err := ti.Pipeline.GetConnectionNameForAsset(ti.GetAsset())
 	if err != nil {
 		return err
 	}
 
+	c.deriveFailingRowsQuery()
+	c.severity, c.warnIf, c.errorIf = ti.Check.Severity, ti.Check.WarnIf, ti.Check.ErrorIf
+	if err := c.scopeToInterval(ctx, ti.Pipeline, ti.GetAsset(), ti.Check.Scope); err != nil {
+		return err
+	}
+
 	annotatedQuery, err := AddColumnCheckAnnotationComment(ctx, c.queryInstance, ti.GetAsset().Name, ti.Column.Name, c.checkName, ti.Pipeline.Name)
 	if err != nil {
 		return errors.Wrap(err, "failed to add annotation comment")
@@ -61,7 +85,7 @@ func (c *CountableQueryCheck) Check(ctx context.Context, ti *scheduler.ColumnChe
 	c.queryInstance = annotatedQuery
 	ti.ExecutedQuery = c.queryInstance.Query
 
-	return c.check(ctx, conn)
+	return passWithWarning(c.check(ctx, conn), &ti.Warning)
 }
 
 func (c *CountableQueryCheck) CustomCheck(ctx context.Context, ti *scheduler.CustomCheckInstance) error {
@@ -70,6 +94,12 @@ func (c *CountableQueryCheck) CustomCheck(ctx context.Context, ti *scheduler.Cus
 		return err
 	}
 
+	c.deriveFailingRowsQuery()
+	c.severity, c.warnIf, c.errorIf = ti.Check.Severity, ti.Check.WarnIf, ti.Check.ErrorIf
+	if err := c.scopeToInterval(ctx, ti.Pipeline, ti.GetAsset(), ti.Check.Scope); err != nil {
+		return err
+	}
+
 	annotatedQuery, err := AddCustomCheckAnnotationComment(ctx, c.queryInstance, ti.GetAsset().Name, c.checkName, ti.Pipeline.Name)
 	if err != nil {
 		return errors.Wrap(err, "failed to add annotation comment")
@@ -77,7 +107,7 @@ func (c *CountableQueryCheck) CustomCheck(ctx context.Context, ti *scheduler.Cus
 	c.queryInstance = annotatedQuery
 	ti.ExecutedQuery = c.queryInstance.Query
 
-	return c.check(ctx, conn)
+	return passWithWarning(c.check(ctx, conn), &ti.Warning)
 }
 
 func (c *CountableQueryCheck) check(ctx context.Context, connectionName string) error {
@@ -101,18 +131,80 @@ func (c *CountableQueryCheck) check(ctx context.Context, connectionName string)
 		return errors.Wrapf(err, "failed to parse '%s' check result", c.checkName)
 	}
 
-	if count != c.expectedQueryResult {
-		return &CheckError{
-			Query:    c.queryInstance.Query,
-			Result:   count,
-			Expected: c.expectedQueryResult,
-			Message:  c.customError(count).Error(),
-		}
+	severity, err := pipeline.ResultSeverity(c.severity, c.warnIf, c.errorIf, count, c.expectedQueryResult)
+	if err != nil {
+		return errors.Wrapf(err, "failed to evaluate '%s' check result", c.checkName)
+	}
+	if severity == "" {
+		return nil
+	}
+
+	checkErr := &CheckError{
+		Query:    c.queryInstance.Query,
+		Result:   count,
+		Expected: c.expectedQueryResult,
+		Message:  c.customError(count).Error(),
+		Severity: severity,
+	}
+
+	// the rows can only be told apart as violating when the check expects none of them
+	if size := FailingRowsSampleSize(ctx); size > 0 && c.expectedQueryResult == 0 && c.failingRowsQuery != "" {
+		checkErr.FailingRows = sampleFailingRows(ctx, s, c.failingRowsQuery, size)
+	}
+
+	return checkErr
+}
+
+// passWithWarning records the checks that failed with the "warn" severity as warnings of the check instance, so that
+// they are reported without failing the check.
+func passWithWarning(err error, warning *error) error {
+	var checkErr *CheckError
+	if errors.As(err, &checkErr) && checkErr.Severity == pipeline.CheckSeverityWarn {
+		*warning = checkErr
+		return nil
+	}
+
+	return err
+}
+
+// scopeToInterval limits the check to the rows written in the interval of the run when the check asks for it. A full
+// refresh rewrites the whole table, so the whole table is checked then.
+func (c *CountableQueryCheck) scopeToInterval(ctx context.Context, p *pipeline.Pipeline, asset *pipeline.Asset, scope pipeline.CheckScope) error {
+	if scope != pipeline.CheckScopeInterval {
+		return nil
+	}
+	if fullRefresh, _ := ctx.Value(pipeline.RunConfigFullRefresh).(bool); fullRefresh {
+		return nil
+	}
+
+	filter, err := intervalFilter(ctx, p, asset)
+	if err != nil {
+		return errors.Wrapf(err, "failed to scope the '%s' check to the interval", c.checkName)
+	}
+
+	scoped, ok := scopeQueryToInterval(c.queryInstance.Query, asset.Name, filter)
+	if !ok {
+		return errors.Errorf("failed to scope the '%s' check to the interval, its query does not select from '%s'", c.checkName, asset.Name)
+	}
+
+	scopedQuery := *c.queryInstance
+	scopedQuery.Query = scoped
+	c.queryInstance = &scopedQuery
+
+	if c.failingRowsQuery != "" {
+		c.failingRowsQuery, _ = scopeQueryToInterval(c.failingRowsQuery, asset.Name, filter)
 	}
 
 	return nil
 }
 
+// deriveFailingRowsQuery has to run before the query is annotated, the annotations would hide the count.
+func (c *CountableQueryCheck) deriveFailingRowsQuery() {
+	if c.failingRowsQuery == "" {
+		c.failingRowsQuery = failingRowsQueryFor(c.queryInstance.Query)
+	}
+}
+
 type NotNullCheck struct {
 	conn config.ConnectionGetter
 }
@@ -147,9 +239,10 @@ func (c *UniqueCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInstan
 	qq := fmt.Sprintf("SELECT COUNT(%s) - COUNT(DISTINCT %s) FROM %s", ti.Column.Name, ti.Column.Name, ti.GetAsset().Name)
 
 	return (&CountableQueryCheck{
-		conn:          c.conn,
-		queryInstance: &query.Query{Query: qq},
-		checkName:     "unique",
+		conn:             c.conn,
+		queryInstance:    &query.Query{Query: qq},
+		failingRowsQuery: DuplicateValuesQuery(ti.GetAsset().Name, ti.Column.Name, "COUNT"),
+		checkName:        "unique",
 		customError: func(count int64) error {
 			return errors.Errorf("column '%s' has %d non-unique values", ti.Column.Name, count)
 		},
@@ -219,6 +312,15 @@ func (c *NegativeCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInst
 	}).Check(ctx, ti)
 }
 
+// DuplicateValuesQuery selects the values that appear more than once in the column together with their number of
+// occurrences, countFunc allows the platforms to use a wider count such as COUNT_BIG.
+func DuplicateValuesQuery(table, column, countFunc string) string {
+	return fmt.Sprintf(
+		"SELECT %s, %s(*) AS occurrences FROM %s WHERE %s IS NOT NULL GROUP BY %s HAVING %s(*) > 1",
+		column, countFunc, table, column, column, countFunc,
+	)
+}
+
 func thresholdSQLValue(intPtr *int, floatPtr *float64, stringPtr *string, checkName string) (string, error) {
 	switch {
 	case intPtr != nil:
@@ -280,6 +382,36 @@ func (c *MaxCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInstance)
 	}).Check(ctx, ti)
 }
 
+type RelationshipsCheck struct {
+	conn config.ConnectionGetter
+}
+
+func NewRelationshipsCheck(conn config.ConnectionGetter) *RelationshipsCheck {
+	return &RelationshipsCheck{conn: conn}
+}
+
+func (c *RelationshipsCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInstance) error {
+	ref := ti.Check.Value.Reference
+	if ref == nil || ref.Asset == "" || ref.Column == "" {
+		return errors.New("unexpected value for relationships check, the value must have the referenced 'asset' and 'column'")
+	}
+
+	// NOT IN is used instead of a correlated subquery or a join since it works the same way across all the platforms
+	qq := fmt.Sprintf(
+		"SELECT count(*) FROM %s WHERE %s IS NOT NULL AND %s NOT IN (SELECT %s FROM %s WHERE %s IS NOT NULL)",
+		ti.GetAsset().Name, ti.Column.Name, ti.Column.Name, ref.Column, ref.Asset, ref.Column,
+	)
+
+	return (&CountableQueryCheck{
+		conn:          c.conn,
+		queryInstance: &query.Query{Query: qq},
+		checkName:     "relationships",
+		customError: func(count int64) error {
+			return errors.Errorf("column '%s' has %d values that do not exist in '%s.%s'", ti.Column.Name, count, ref.Asset, ref.Column)
+		},
+	}).Check(ctx, ti)
+}
+
 type CustomCheck struct {
 	conn     config.ConnectionGetter
 	renderer jinja.RendererInterface
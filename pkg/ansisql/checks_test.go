@@ -504,3 +504,131 @@ func TestMaxCheck_String(t *testing.T) {
 }
 
 func ptrToInt(i int) *int { return &i }
+
+func TestRelationshipsCheck_Check(t *testing.T) {
+	t.Parallel()
+
+	runTestsFoCountZeroCheck(
+		t,
+		func(q *mockQuerierWithResult) CheckRunner {
+			conn := new(mockConnectionFetcher)
+			conn.On("GetConnection", "test").Return(q, nil)
+			return NewRelationshipsCheck(conn)
+		},
+		"SELECT count(*) FROM dataset.test_asset WHERE test_column IS NOT NULL AND test_column NOT IN (SELECT id FROM raw.customers WHERE id IS NOT NULL)",
+		"column 'test_column' has 5 values that do not exist in 'raw.customers.id'",
+		&pipeline.ColumnCheck{
+			Name: "relationships",
+			Value: pipeline.ColumnCheckValue{
+				Reference: &pipeline.ColumnReference{Asset: "raw.customers", Column: "id"},
+			},
+		},
+	)
+}
+
+func TestRelationshipsCheck_MissingReference(t *testing.T) {
+	t.Parallel()
+
+	check := NewRelationshipsCheck(new(mockConnectionFetcher))
+	err := check.Check(t.Context(), &scheduler.ColumnCheckInstance{
+		AssetInstance: &scheduler.AssetInstance{Asset: &pipeline.Asset{Name: "dataset.test_asset"}},
+		Column:        &pipeline.Column{Name: "test_column"},
+		Check: &pipeline.ColumnCheck{
+			Name:  "relationships",
+			Value: pipeline.ColumnCheckValue{Reference: &pipeline.ColumnReference{Asset: "raw.customers"}},
+		},
+	})
+	assert.EqualError(t, err, "unexpected value for relationships check, the value must have the referenced 'asset' and 'column'")
+}
+
+func TestNotNullCheck_Severity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		check       pipeline.ColumnCheck
+		result      int64
+		wantErr     string
+		wantWarning string
+	}{
+		{
+			name:    "error severity fails the check",
+			check:   pipeline.ColumnCheck{Name: "not_null", Severity: pipeline.CheckSeverityError},
+			result:  5,
+			wantErr: "column 'test_column' has 5 null values",
+		},
+		{
+			name:        "warn severity passes the check with a warning",
+			check:       pipeline.ColumnCheck{Name: "not_null", Severity: pipeline.CheckSeverityWarn},
+			result:      5,
+			wantWarning: "column 'test_column' has 5 null values",
+		},
+		{
+			name:   "warn severity passes silently when the check passes",
+			check:  pipeline.ColumnCheck{Name: "not_null", Severity: pipeline.CheckSeverityWarn},
+			result: 0,
+		},
+		{
+			name:        "result below the error threshold warns",
+			check:       pipeline.ColumnCheck{Name: "not_null", ErrorIf: ">10"},
+			result:      5,
+			wantWarning: "column 'test_column' has 5 null values",
+		},
+		{
+			name:    "result above the error threshold fails",
+			check:   pipeline.ColumnCheck{Name: "not_null", ErrorIf: ">10"},
+			result:  11,
+			wantErr: "column 'test_column' has 11 null values",
+		},
+		{
+			name:   "result below the warn threshold passes",
+			check:  pipeline.ColumnCheck{Name: "not_null", Severity: pipeline.CheckSeverityWarn, WarnIf: ">10"},
+			result: 5,
+		},
+		{
+			name:    "invalid threshold",
+			check:   pipeline.ColumnCheck{Name: "not_null", ErrorIf: "lots"},
+			result:  5,
+			wantErr: "failed to evaluate 'not_null' check result: invalid threshold 'lots', it must be a comparison such as '>10' or '!=0'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := new(mockQuerierWithResult)
+			q.On("Select", mock.Anything, &query.Query{Query: "SELECT count(*) FROM dataset.test_asset WHERE test_column IS NULL"}).
+				Return([][]interface{}{{tt.result}}, nil).
+				Once()
+
+			conn := new(mockConnectionFetcher)
+			conn.On("GetConnection", "test").Return(q, nil)
+
+			instance := &scheduler.ColumnCheckInstance{
+				AssetInstance: &scheduler.AssetInstance{
+					Asset: &pipeline.Asset{Name: "dataset.test_asset", Type: pipeline.AssetTypeBigqueryQuery},
+					Pipeline: &pipeline.Pipeline{
+						Name:               "test",
+						DefaultConnections: map[string]string{"google_cloud_platform": "test"},
+					},
+				},
+				Column: &pipeline.Column{Name: "test_column"},
+				Check:  &tt.check,
+			}
+
+			err := (&NotNullCheck{conn: conn}).Check(t.Context(), instance)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.wantWarning != "" {
+				assert.EqualError(t, instance.Warning, tt.wantWarning)
+			} else {
+				assert.NoError(t, instance.Warning)
+			}
+			q.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package ansisql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+)
+
+// countQueryRegex matches the checks that count the violating rows of a table, e.g. "SELECT count(*) FROM t WHERE
+// c IS NULL", the rows themselves can be fetched by selecting them instead of counting them.
+var countQueryRegex = regexp.MustCompile(`(?is)^\s*SELECT\s+COUNT(?:_BIG)?\s*\(\s*\*\s*\)\s+(FROM\s.+)$`)
+
+// FailingRows is a bounded sample of the rows that violate a failed check.
+type FailingRows struct {
+	Query   string          `json:"query"`
+	Columns []string        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+	// Error is set when the check failed but its failing rows could not be fetched.
+	Error string `json:"error,omitempty"`
+	// Path is the file the sample is saved to, if any.
+	Path string `json:"path,omitempty"`
+}
+
+type limiter interface {
+	Limit(query string, limit int64) string
+}
+
+type schemaSelector interface {
+	SelectWithSchema(ctx context.Context, queryObj *query.Query) (*query.QueryResult, error)
+}
+
+// FailingRowsSampleSize returns the number of failing rows to fetch for the failed checks in the run, 0 means the
+// failing rows are not fetched at all.
+func FailingRowsSampleSize(ctx context.Context) int {
+	size, ok := ctx.Value(pipeline.RunConfigFailingRowsSample).(int)
+	if !ok || size < 0 {
+		return 0
+	}
+
+	return size
+}
+
+// failingRowsQueryFor derives the query that selects the violating rows from the query that counts them, it returns
+// an empty string for the queries that do not simply count the rows of a table.
+func failingRowsQueryFor(countQuery string) string {
+	match := countQueryRegex.FindStringSubmatch(countQuery)
+	if match == nil {
+		return ""
+	}
+
+	return "SELECT * " + match[1]
+}
+
+// limitQuery bounds the number of rows the query returns, using the dialect of the connection if it knows how to.
+func limitQuery(conn any, qq string, limit int64) string {
+	if l, ok := conn.(limiter); ok {
+		return l.Limit(qq, limit)
+	}
+
+	qq = strings.TrimRight(qq, "; \n\t")
+	return fmt.Sprintf("SELECT * FROM (\n%s\n) t LIMIT %d", qq, limit)
+}
+
+// sampleFailingRows fetches up to the given number of rows from the failing rows query. The errors are reported in the
+// result rather than returned, since the check has failed already and the sample is only there to help debugging it.
+func sampleFailingRows(ctx context.Context, conn selector, failingRowsQuery string, size int) *FailingRows {
+	q := &query.Query{Query: limitQuery(conn, failingRowsQuery, int64(size))}
+	sample := &FailingRows{Query: q.Query}
+
+	if s, ok := conn.(schemaSelector); ok {
+		res, err := s.SelectWithSchema(ctx, q)
+		if err != nil {
+			sample.Error = err.Error()
+			return sample
+		}
+
+		sample.Columns = res.Columns
+		sample.Rows = res.Rows
+		return sample
+	}
+
+	rows, err := conn.Select(ctx, q)
+	if err != nil {
+		sample.Error = err.Error()
+		return sample
+	}
+	sample.Rows = rows
+
+	return sample
+}
@@ -0,0 +1,142 @@
+package ansisql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailingRowsQueryFor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: "SELECT count(*) FROM dataset.orders WHERE id IS NULL",
+			want:  "SELECT * FROM dataset.orders WHERE id IS NULL",
+		},
+		{
+			query: "SELECT COUNT_BIG(*) FROM [dbo].[orders]\nWHERE [status] NOT LIKE 'a%'",
+			want:  "SELECT * FROM [dbo].[orders]\nWHERE [status] NOT LIKE 'a%'",
+		},
+		{
+			query: "SELECT count(*) FROM (SELECT * FROM orders WHERE amount < 0) AS t",
+			want:  "SELECT * FROM (SELECT * FROM orders WHERE amount < 0) AS t",
+		},
+		{
+			query: "SELECT COUNT(id) - COUNT(DISTINCT id) FROM dataset.orders",
+			want:  "",
+		},
+		{
+			query: "SELECT max(amount) FROM dataset.orders",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, failingRowsQueryFor(tt.query), tt.query)
+	}
+}
+
+func TestCountableQueryCheck_FailingRows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		sampleSize    any
+		checker       func(conn *mockConnectionFetcher) CheckRunner
+		countQuery    string
+		samplingQuery string
+		sampleResult  [][]interface{}
+		sampleErr     error
+		want          *FailingRows
+	}{
+		{
+			name:          "violating rows are sampled",
+			sampleSize:    5,
+			checker:       func(conn *mockConnectionFetcher) CheckRunner { return NewNotNullCheck(conn) },
+			countQuery:    "SELECT count(*) FROM dataset.test_asset WHERE test_column IS NULL",
+			samplingQuery: "SELECT * FROM (\nSELECT * FROM dataset.test_asset WHERE test_column IS NULL\n) t LIMIT 5",
+			sampleResult:  [][]interface{}{{int64(1), nil}, {int64(2), nil}},
+			want: &FailingRows{
+				Query: "SELECT * FROM (\nSELECT * FROM dataset.test_asset WHERE test_column IS NULL\n) t LIMIT 5",
+				Rows:  [][]interface{}{{int64(1), nil}, {int64(2), nil}},
+			},
+		},
+		{
+			name:          "duplicates are sampled for the unique check",
+			sampleSize:    10,
+			checker:       func(conn *mockConnectionFetcher) CheckRunner { return NewUniqueCheck(conn) },
+			countQuery:    "SELECT COUNT(test_column) - COUNT(DISTINCT test_column) FROM dataset.test_asset",
+			samplingQuery: "SELECT * FROM (\nSELECT test_column, COUNT(*) AS occurrences FROM dataset.test_asset WHERE test_column IS NOT NULL GROUP BY test_column HAVING COUNT(*) > 1\n) t LIMIT 10",
+			sampleResult:  [][]interface{}{{"a", int64(3)}},
+			want: &FailingRows{
+				Query: "SELECT * FROM (\nSELECT test_column, COUNT(*) AS occurrences FROM dataset.test_asset WHERE test_column IS NOT NULL GROUP BY test_column HAVING COUNT(*) > 1\n) t LIMIT 10",
+				Rows:  [][]interface{}{{"a", int64(3)}},
+			},
+		},
+		{
+			name:          "sampling errors are reported in the sample",
+			sampleSize:    5,
+			checker:       func(conn *mockConnectionFetcher) CheckRunner { return NewPositiveCheck(conn) },
+			countQuery:    "SELECT count(*) FROM dataset.test_asset WHERE test_column <= 0",
+			samplingQuery: "SELECT * FROM (\nSELECT * FROM dataset.test_asset WHERE test_column <= 0\n) t LIMIT 5",
+			sampleErr:     assert.AnError,
+			want: &FailingRows{
+				Query: "SELECT * FROM (\nSELECT * FROM dataset.test_asset WHERE test_column <= 0\n) t LIMIT 5",
+				Error: assert.AnError.Error(),
+			},
+		},
+		{
+			name:       "nothing is sampled unless requested",
+			checker:    func(conn *mockConnectionFetcher) CheckRunner { return NewNotNullCheck(conn) },
+			countQuery: "SELECT count(*) FROM dataset.test_asset WHERE test_column IS NULL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := new(mockQuerierWithResult)
+			q.On("Select", mock.Anything, &query.Query{Query: tt.countQuery}).Return([][]interface{}{{int64(2)}}, nil).Once()
+			if tt.samplingQuery != "" {
+				q.On("Select", mock.Anything, &query.Query{Query: tt.samplingQuery}).Return(tt.sampleResult, tt.sampleErr).Once()
+			}
+
+			conn := new(mockConnectionFetcher)
+			conn.On("GetConnection", "test").Return(q)
+
+			instance := &scheduler.ColumnCheckInstance{
+				AssetInstance: &scheduler.AssetInstance{
+					Asset: &pipeline.Asset{Name: "dataset.test_asset", Type: pipeline.AssetTypePostgresQuery, Connection: "test"},
+					Pipeline: &pipeline.Pipeline{
+						Name: "test",
+					},
+				},
+				Column: &pipeline.Column{Name: "test_column"},
+				Check:  &pipeline.ColumnCheck{Name: "check"},
+			}
+
+			ctx := context.Background()
+			if tt.sampleSize != nil {
+				ctx = context.WithValue(ctx, pipeline.RunConfigFailingRowsSample, tt.sampleSize)
+			}
+
+			err := tt.checker(conn).Check(ctx, instance)
+
+			var checkErr *CheckError
+			require.ErrorAs(t, err, &checkErr)
+			assert.Equal(t, tt.want, checkErr.FailingRows)
+			q.AssertExpectations(t)
+		})
+	}
+}
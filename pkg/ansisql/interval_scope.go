@@ -0,0 +1,88 @@
+package ansisql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/jinja"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/pkg/errors"
+)
+
+// intervalScopeCTE is the name the rows written in the run interval are selected under in the scoped check queries.
+const intervalScopeCTE = "bruin_interval_rows"
+
+var leadingWithRegex = regexp.MustCompile(`(?is)^\s*WITH\s+`)
+
+// intervalFilter renders the condition that selects the rows written in the interval of the run, the same way the
+// time_interval materialization selects the rows it replaces.
+func intervalFilter(ctx context.Context, p *pipeline.Pipeline, asset *pipeline.Asset) (string, error) {
+	if err := asset.ValidateIntervalScope(); err != nil {
+		return "", err
+	}
+
+	for _, key := range []pipeline.RunConfig{pipeline.RunConfigStartDate, pipeline.RunConfigEndDate, pipeline.RunConfigExecutionDate} {
+		if _, ok := ctx.Value(key).(time.Time); !ok {
+			return "", errors.Errorf("checks with the '%s' scope require the %s of the run", pipeline.CheckScopeInterval, key)
+		}
+	}
+	if _, ok := ctx.Value(pipeline.RunConfigRunID).(string); !ok {
+		return "", errors.Errorf("checks with the '%s' scope require the run ID", pipeline.CheckScopeInterval)
+	}
+
+	startVar := "{{ start_timestamp }}"
+	endVar := "{{ end_timestamp }}"
+	if asset.Materialization.TimeGranularity == pipeline.MaterializationTimeGranularityDate {
+		startVar = "{{ start_date }}"
+		endVar = "{{ end_date }}"
+	}
+
+	// the renderer of the asset applies its interval modifiers, the checks must see the same interval the asset wrote
+	renderer, err := jinja.NewRendererWithYesterday(p.Name, "").CloneForAsset(ctx, p, asset)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create renderer for asset")
+	}
+
+	filter, err := renderer.Render(fmt.Sprintf("%s BETWEEN '%s' AND '%s'", asset.Materialization.IncrementalKey, startVar, endVar))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to render the interval of the check")
+	}
+
+	return filter, nil
+}
+
+// tableReferenceRegex matches the table being selected from or joined, whether its name parts are quoted or not.
+func tableReferenceRegex(table string) *regexp.Regexp {
+	parts := strings.Split(table, ".")
+	quotedParts := make([]string, len(parts))
+	for i, part := range parts {
+		p := regexp.QuoteMeta(part)
+		quotedParts[i] = fmt.Sprintf("(?:%s|\"%s\"|`%s`|\\[%s\\])", p, p, p, p)
+	}
+
+	name := strings.Join(quotedParts, `\.`) + "|`" + regexp.QuoteMeta(table) + "`"
+
+	return regexp.MustCompile(`(?i)\b(FROM|JOIN)(\s+)(` + name + `)([^\w.\]"` + "`" + `]|$)`)
+}
+
+// scopeQueryToInterval makes the query read the rows matching the filter instead of the whole table, by selecting them
+// in a CTE and pointing the references to the table at it. It returns false if the query does not read the table.
+func scopeQueryToInterval(qq, table, filter string) (string, bool) {
+	ref := tableReferenceRegex(table)
+	match := ref.FindStringSubmatch(qq)
+	if match == nil {
+		return qq, false
+	}
+
+	cte := fmt.Sprintf("%s AS (SELECT * FROM %s WHERE %s)", intervalScopeCTE, match[3], filter)
+	scoped := ref.ReplaceAllString(qq, "${1}${2}"+intervalScopeCTE+"${4}")
+
+	if loc := leadingWithRegex.FindStringIndex(scoped); loc != nil {
+		return "WITH " + cte + ",\n" + scoped[loc[1]:], true
+	}
+
+	return "WITH " + cte + "\n" + scoped, true
+}
@@ -0,0 +1,247 @@
+package ansisql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func intervalContext(t *testing.T) context.Context {
+	t.Helper()
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 23, 59, 59, 999999000, time.UTC)
+
+	ctx := context.WithValue(t.Context(), pipeline.RunConfigStartDate, start)
+	ctx = context.WithValue(ctx, pipeline.RunConfigEndDate, end)
+	ctx = context.WithValue(ctx, pipeline.RunConfigExecutionDate, start)
+	return context.WithValue(ctx, pipeline.RunConfigRunID, "test-run")
+}
+
+func TestScopeQueryToInterval(t *testing.T) {
+	t.Parallel()
+
+	filter := "dt BETWEEN '2024-06-01' AND '2024-06-01'"
+
+	tests := []struct {
+		name   string
+		query  string
+		table  string
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "simple count",
+			query:  "SELECT count(*) FROM dataset.orders WHERE id IS NULL",
+			table:  "dataset.orders",
+			want:   "WITH bruin_interval_rows AS (SELECT * FROM dataset.orders WHERE dt BETWEEN '2024-06-01' AND '2024-06-01')\nSELECT count(*) FROM bruin_interval_rows WHERE id IS NULL",
+			wantOk: true,
+		},
+		{
+			name:   "quoted name parts",
+			query:  "SELECT COUNT_BIG(*) FROM [dbo].[orders] WHERE [id] IS NULL",
+			table:  "dbo.orders",
+			want:   "WITH bruin_interval_rows AS (SELECT * FROM [dbo].[orders] WHERE dt BETWEEN '2024-06-01' AND '2024-06-01')\nSELECT COUNT_BIG(*) FROM bruin_interval_rows WHERE [id] IS NULL",
+			wantOk: true,
+		},
+		{
+			name:   "backticked name with an alias and a join",
+			query:  "SELECT count(*) FROM `dataset.orders` o JOIN dataset.orders p ON o.parent = p.id",
+			table:  "dataset.orders",
+			want:   "WITH bruin_interval_rows AS (SELECT * FROM `dataset.orders` WHERE dt BETWEEN '2024-06-01' AND '2024-06-01')\nSELECT count(*) FROM bruin_interval_rows o JOIN bruin_interval_rows p ON o.parent = p.id",
+			wantOk: true,
+		},
+		{
+			name:   "existing CTEs are kept",
+			query:  "with late as (select * from dataset.orders where late) select count(*) from late",
+			table:  "dataset.orders",
+			want:   "WITH bruin_interval_rows AS (SELECT * FROM dataset.orders WHERE dt BETWEEN '2024-06-01' AND '2024-06-01'),\nlate as (select * from bruin_interval_rows where late) select count(*) from late",
+			wantOk: true,
+		},
+		{
+			name:   "other tables with the same prefix are left alone",
+			query:  "SELECT count(*) FROM dataset.orders_archive WHERE id NOT IN (SELECT id FROM dataset.orders)",
+			table:  "dataset.orders",
+			want:   "WITH bruin_interval_rows AS (SELECT * FROM dataset.orders WHERE dt BETWEEN '2024-06-01' AND '2024-06-01')\nSELECT count(*) FROM dataset.orders_archive WHERE id NOT IN (SELECT id FROM bruin_interval_rows)",
+			wantOk: true,
+		},
+		{
+			name:  "the table is not read",
+			query: "SELECT count(*) FROM dataset.customers",
+			table: "dataset.orders",
+			want:  "SELECT count(*) FROM dataset.customers",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := scopeQueryToInterval(tt.query, tt.table, filter)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIntervalFilter(t *testing.T) {
+	t.Parallel()
+
+	p := &pipeline.Pipeline{Name: "test"}
+
+	tests := []struct {
+		name            string
+		materialization pipeline.Materialization
+		columns         []pipeline.Column
+		want            string
+		wantErr         string
+	}{
+		{
+			name: "timestamp granularity",
+			materialization: pipeline.Materialization{
+				Strategy:        pipeline.MaterializationStrategyTimeInterval,
+				IncrementalKey:  "created_at",
+				TimeGranularity: pipeline.MaterializationTimeGranularityTimestamp,
+			},
+			want: "created_at BETWEEN '2024-06-01T00:00:00.000000Z' AND '2024-06-01T23:59:59.999999Z'",
+		},
+		{
+			name: "date granularity",
+			materialization: pipeline.Materialization{
+				Strategy:        pipeline.MaterializationStrategyTimeInterval,
+				IncrementalKey:  "dt",
+				TimeGranularity: pipeline.MaterializationTimeGranularityDate,
+			},
+			want: "dt BETWEEN '2024-06-01' AND '2024-06-01'",
+		},
+		{
+			name: "delete+insert by a timestamp",
+			materialization: pipeline.Materialization{
+				Strategy:       pipeline.MaterializationStrategyDeleteInsert,
+				IncrementalKey: "created_at",
+			},
+			columns: []pipeline.Column{{Name: "created_at", Type: "TIMESTAMP"}},
+			want:    "created_at BETWEEN '2024-06-01T00:00:00.000000Z' AND '2024-06-01T23:59:59.999999Z'",
+		},
+		{
+			name: "delete+insert by an ID",
+			materialization: pipeline.Materialization{
+				Strategy:       pipeline.MaterializationStrategyDeleteInsert,
+				IncrementalKey: "order_id",
+			},
+			columns: []pipeline.Column{{Name: "order_id", Type: "INTEGER"}},
+			wantErr: "checks with the 'interval' scope require the 'incremental_key' of a 'delete+insert' asset to be a column with a date or timestamp type",
+		},
+		{
+			name: "delete+insert by an undeclared column",
+			materialization: pipeline.Materialization{
+				Strategy:       pipeline.MaterializationStrategyDeleteInsert,
+				IncrementalKey: "created_at",
+			},
+			wantErr: "checks with the 'interval' scope require the 'incremental_key' of a 'delete+insert' asset to be a column with a date or timestamp type",
+		},
+		{
+			name:            "not incremental",
+			materialization: pipeline.Materialization{Strategy: pipeline.MaterializationStrategyCreateReplace},
+			wantErr:         "checks with the 'interval' scope require the asset to use the 'time_interval' or 'delete+insert' materialization strategy",
+		},
+		{
+			name:            "missing incremental key",
+			materialization: pipeline.Materialization{Strategy: pipeline.MaterializationStrategyTimeInterval},
+			wantErr:         "checks with the 'interval' scope require the asset to have an 'incremental_key'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			asset := &pipeline.Asset{Name: "dataset.orders", Materialization: tt.materialization, Columns: tt.columns}
+			got, err := intervalFilter(intervalContext(t), p, asset)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNotNullCheck_IntervalScope(t *testing.T) {
+	t.Parallel()
+
+	newInstance := func(scope pipeline.CheckScope) *scheduler.ColumnCheckInstance {
+		return &scheduler.ColumnCheckInstance{
+			AssetInstance: &scheduler.AssetInstance{
+				Asset: &pipeline.Asset{
+					Name: "dataset.orders",
+					Type: pipeline.AssetTypeBigqueryQuery,
+					Materialization: pipeline.Materialization{
+						Type:            pipeline.MaterializationTypeTable,
+						Strategy:        pipeline.MaterializationStrategyTimeInterval,
+						IncrementalKey:  "dt",
+						TimeGranularity: pipeline.MaterializationTimeGranularityDate,
+					},
+				},
+				Pipeline: &pipeline.Pipeline{
+					Name:               "test",
+					DefaultConnections: map[string]string{"google_cloud_platform": "test"},
+				},
+			},
+			Column: &pipeline.Column{Name: "id"},
+			Check:  &pipeline.ColumnCheck{Name: "not_null", Scope: scope},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		scope     pipeline.CheckScope
+		ctx       func(t *testing.T) context.Context
+		wantQuery string
+	}{
+		{
+			name:      "whole table by default",
+			ctx:       intervalContext,
+			wantQuery: "SELECT count(*) FROM dataset.orders WHERE id IS NULL",
+		},
+		{
+			name:      "interval scope",
+			scope:     pipeline.CheckScopeInterval,
+			ctx:       intervalContext,
+			wantQuery: "WITH bruin_interval_rows AS (SELECT * FROM dataset.orders WHERE dt BETWEEN '2024-06-01' AND '2024-06-01')\nSELECT count(*) FROM bruin_interval_rows WHERE id IS NULL",
+		},
+		{
+			name:  "full refresh checks the whole table",
+			scope: pipeline.CheckScopeInterval,
+			ctx: func(t *testing.T) context.Context {
+				return context.WithValue(intervalContext(t), pipeline.RunConfigFullRefresh, true)
+			},
+			wantQuery: "SELECT count(*) FROM dataset.orders WHERE id IS NULL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := new(mockQuerierWithResult)
+			q.On("Select", mock.Anything, &query.Query{Query: tt.wantQuery}).
+				Return([][]interface{}{{int64(0)}}, nil).
+				Once()
+
+			conn := new(mockConnectionFetcher)
+			conn.On("GetConnection", "test").Return(q, nil)
+
+			instance := newInstance(tt.scope)
+			require.NoError(t, NewNotNullCheck(conn).Check(tt.ctx(t), instance))
+			assert.Equal(t, tt.wantQuery, instance.ExecutedQuery)
+			q.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package ansisql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/bruin-data/bruin/pkg/helpers"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/pkg/errors"
+)
+
+// freshnessQueries return the number of seconds passed since the latest value of a timestamp column. Every platform
+// spells the difference between two timestamps differently, the keys are the prefixes of the asset types, e.g. "bq"
+// for "bq.sql", and the platforms that are not listed use the ANSI version.
+var freshnessQueries = map[string]string{
+	"bq":         "SELECT TIMESTAMP_DIFF(CURRENT_TIMESTAMP(), CAST(MAX(%s) AS TIMESTAMP), SECOND) FROM %s",
+	"sf":         "SELECT DATEDIFF('second', MAX(%s), CURRENT_TIMESTAMP()) FROM %s",
+	"rs":         "SELECT DATEDIFF(second, MAX(%s), GETDATE()) FROM %s",
+	"ms":         "SELECT DATEDIFF(SECOND, MAX(%s), CURRENT_TIMESTAMP) FROM %s",
+	"synapse":    "SELECT DATEDIFF(SECOND, MAX(%s), CURRENT_TIMESTAMP) FROM %s",
+	"fabric":     "SELECT DATEDIFF(SECOND, MAX(%s), CURRENT_TIMESTAMP) FROM %s",
+	"databricks": "SELECT unix_timestamp(current_timestamp()) - unix_timestamp(MAX(%s)) FROM %s",
+	"athena":     "SELECT date_diff('second', CAST(MAX(%s) AS timestamp), CAST(current_timestamp AS timestamp)) FROM %s",
+	"trino":      "SELECT date_diff('second', CAST(MAX(%s) AS timestamp), CAST(current_timestamp AS timestamp)) FROM %s",
+	"clickhouse": "SELECT dateDiff('second', MAX(%s), now()) FROM %s",
+	"vertica":    "SELECT DATEDIFF('second', MAX(%s), CURRENT_TIMESTAMP) FROM %s",
+	"my":         "SELECT TIMESTAMPDIFF(SECOND, MAX(%s), CURRENT_TIMESTAMP) FROM %s",
+	"oracle":     "SELECT ROUND((CAST(SYSTIMESTAMP AS DATE) - CAST(MAX(%s) AS DATE)) * 86400) FROM %s",
+	"duckdb":     "SELECT CAST(EPOCH(CURRENT_TIMESTAMP) - EPOCH(MAX(%s)) AS BIGINT) FROM %s",
+}
+
+const ansiFreshnessQuery = "SELECT CAST(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - MAX(%s))) AS BIGINT) FROM %s"
+
+// FreshnessQuery builds the query that returns the age of the latest value in the given column in seconds, in the
+// dialect of the given asset type.
+func FreshnessQuery(assetType pipeline.AssetType, table, column string) string {
+	platform, _, _ := strings.Cut(string(assetType), ".")
+	tmpl, ok := freshnessQueries[platform]
+	if !ok {
+		tmpl = ansiFreshnessQuery
+	}
+
+	return fmt.Sprintf(tmpl, column, table)
+}
+
+// TableCheckHistory gives access to the values the table checks observed in the previous runs.
+type TableCheckHistory interface {
+	// LastObservedValue returns the value observed by the most recent run of the table check task with the given ID,
+	// including the runs in which the check failed, the boolean is false if there is no such run.
+	LastObservedValue(taskID string) (int64, bool, error)
+}
+
+type TableCheckOperator struct {
+	conn            config.ConnectionGetter
+	history         TableCheckHistory
+	pythonAssetType pipeline.AssetType
+}
+
+// NewTableCheckOperator creates the operator that runs the table-level checks of the assets. The history is only
+// needed for the row_count_change check, when it is nil every run is treated as the first one. The checks of the Python
+// assets run on the platform the pipeline mostly uses, pythonAssetType is the asset type of that platform.
+func NewTableCheckOperator(conn config.ConnectionGetter, history TableCheckHistory, pythonAssetType pipeline.AssetType) *TableCheckOperator {
+	return &TableCheckOperator{conn: conn, history: history, pythonAssetType: pythonAssetType}
+}
+
+func (o *TableCheckOperator) Run(ctx context.Context, ti scheduler.TaskInstance) error {
+	instance, ok := ti.(*scheduler.TableCheckInstance)
+	if !ok {
+		return errors.New("cannot run a non-table check instance")
+	}
+
+	var err error
+	switch instance.Check.Name {
+	case pipeline.TableCheckRowCount:
+		err = o.rowCount(ctx, instance)
+	case pipeline.TableCheckFreshness:
+		err = o.freshness(ctx, instance)
+	case pipeline.TableCheckRowCountChange:
+		err = o.rowCountChange(ctx, instance)
+	default:
+		return errors.Errorf("unknown table check '%s', available checks are '%s', '%s' and '%s'", instance.Check.Name, pipeline.TableCheckRowCount, pipeline.TableCheckFreshness, pipeline.TableCheckRowCountChange)
+	}
+
+	return passWithWarning(err, &instance.Warning)
+}
+
+func (o *TableCheckOperator) rowCount(ctx context.Context, ti *scheduler.TableCheckInstance) error {
+	qq := "SELECT COUNT(*) FROM " + ti.GetAsset().Name
+	count, err := o.observe(ctx, ti, qq)
+	if err != nil {
+		return err
+	}
+
+	if ti.Check.Min != nil && count < *ti.Check.Min {
+		return o.evaluate(ti, count, &CheckError{
+			Expected: *ti.Check.Min,
+			Message:  fmt.Sprintf("table '%s' has %d rows, below the minimum of %d", ti.GetAsset().Name, count, *ti.Check.Min),
+		})
+	}
+
+	if ti.Check.Max != nil && count > *ti.Check.Max {
+		return o.evaluate(ti, count, &CheckError{
+			Expected: *ti.Check.Max,
+			Message:  fmt.Sprintf("table '%s' has %d rows, above the maximum of %d", ti.GetAsset().Name, count, *ti.Check.Max),
+		})
+	}
+
+	return o.evaluate(ti, count, nil)
+}
+
+func (o *TableCheckOperator) freshness(ctx context.Context, ti *scheduler.TableCheckInstance) error {
+	if ti.Check.Column == "" {
+		return errors.New("freshness check requires a 'column' to be set")
+	}
+
+	maxAge, err := helpers.ParseSensorDuration(ti.Check.MaxAge)
+	if err != nil {
+		return errors.Wrapf(err, "invalid 'max_age' for the freshness check of column '%s'", ti.Check.Column)
+	}
+
+	// ingestr assets are checked in the destination and Python assets on the platform their checks run on, hence the
+	// dialect of that platform is used
+	assetType := ti.GetAsset().Type
+	switch assetType {
+	case pipeline.AssetTypeIngestr:
+		if destination, err := helpers.GetIngestrDestinationType(ti.GetAsset()); err == nil {
+			assetType = destination
+		}
+	case pipeline.AssetTypePython:
+		if o.pythonAssetType != "" {
+			assetType = o.pythonAssetType
+		}
+	}
+
+	age, err := o.observe(ctx, ti, FreshnessQuery(assetType, ti.GetAsset().Name, ti.Check.Column))
+	if err != nil {
+		return err
+	}
+
+	maxAgeSeconds := int64(maxAge / time.Second)
+	if age > maxAgeSeconds {
+		return o.evaluate(ti, age, &CheckError{
+			Expected: maxAgeSeconds,
+			Message: fmt.Sprintf("the latest value of column '%s' is %s old, older than the maximum age of %s",
+				ti.Check.Column, time.Duration(age)*time.Second, ti.Check.MaxAge),
+		})
+	}
+
+	return o.evaluate(ti, age, nil)
+}
+
+func (o *TableCheckOperator) rowCountChange(ctx context.Context, ti *scheduler.TableCheckInstance) error {
+	if ti.Check.MaxChange == nil {
+		return errors.New("row_count_change check requires a 'max_change' percentage to be set")
+	}
+
+	previous, found := int64(0), false
+	if o.history != nil {
+		var err error
+		previous, found, err = o.history.LastObservedValue(ti.GetHumanID())
+		if err != nil {
+			return errors.Wrap(err, "failed to read the row count of the previous run")
+		}
+	}
+
+	count, err := o.observe(ctx, ti, "SELECT COUNT(*) FROM "+ti.GetAsset().Name)
+	if err != nil {
+		return err
+	}
+
+	// there is nothing to compare against in the first run, the row count is recorded for the next one
+	if !found {
+		return nil
+	}
+
+	change := math.Inf(1)
+	switch {
+	case previous == count:
+		change = 0
+	case previous != 0:
+		change = math.Abs(float64(count-previous)) / float64(previous) * 100
+	}
+
+	// the thresholds are compared against the change in percent, a table growing from zero rows changed infinitely
+	roundedChange := int64(math.MaxInt64)
+	if !math.IsInf(change, 1) {
+		roundedChange = int64(math.Round(change))
+	}
+
+	if change > *ti.Check.MaxChange {
+		return o.evaluate(ti, roundedChange, &CheckError{
+			Expected: previous,
+			Message: fmt.Sprintf("table '%s' has %d rows, %s compared to %d rows in the previous run, more than the allowed %s%%",
+				ti.GetAsset().Name, count, formatRowCountChange(previous, count, change), previous, formatPercentage(*ti.Check.MaxChange)),
+		})
+	}
+
+	return o.evaluate(ti, roundedChange, nil)
+}
+
+// evaluate decides how the result of a table check is reported based on its severity and its `warn_if` and `error_if`
+// thresholds. checkErr describes the failure of the check's own condition, it is nil when the condition held; the
+// thresholds default to that condition. The result the thresholds are compared against is the measured value: the
+// row count, the age in seconds or the change in percent.
+func (o *TableCheckOperator) evaluate(ti *scheduler.TableCheckInstance, result int64, checkErr *CheckError) error {
+	severity, err := pipeline.ConditionSeverity(ti.Check.Severity, ti.Check.WarnIf, ti.Check.ErrorIf, result, checkErr != nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to evaluate '%s' check result", ti.Check.Name)
+	}
+	if severity == "" {
+		return nil
+	}
+
+	if checkErr == nil {
+		threshold := ti.Check.WarnIf
+		if severity == pipeline.CheckSeverityError {
+			threshold = ti.Check.ErrorIf
+		}
+
+		checkErr = &CheckError{
+			Message: fmt.Sprintf("the '%s' check of table '%s' measured %d, matching its threshold '%s'",
+				ti.Check.HumanName(), ti.GetAsset().Name, result, threshold),
+		}
+		if parsed, err := pipeline.ParseCheckThreshold(threshold); err == nil {
+			checkErr.Expected = parsed.Value
+		}
+	}
+
+	checkErr.Query = ti.ExecutedQuery
+	checkErr.Result = result
+	checkErr.Severity = severity
+
+	return checkErr
+}
+
+// observe runs the given query against the connection of the asset and records its single integer result as the
+// observed value of the check.
+func (o *TableCheckOperator) observe(ctx context.Context, ti *scheduler.TableCheckInstance, qq string) (int64, error) {
+	connName, err := ti.Pipeline.GetConnectionNameForAsset(ti.GetAsset())
+	if err != nil {
+		return 0, err
+	}
+
+	conn := o.conn.GetConnection(connName)
+	if conn == nil {
+		return 0, config.NewConnectionNotFoundError(ctx, "", connName)
+	}
+
+	s, ok := conn.(selector)
+	if !ok {
+		return 0, errors.Errorf("connection '%s' cannot be used for the table check '%s'", connName, ti.Check.Name)
+	}
+
+	q, err := AddTableCheckAnnotationComment(ctx, &query.Query{Query: qq}, ti.GetAsset().Name, ti.Check.Name, ti.Pipeline.Name)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to add annotation comment")
+	}
+	ti.ExecutedQuery = q.Query
+
+	res, err := s.Select(ctx, q)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed '%s' check", ti.Check.Name)
+	}
+
+	if ti.Check.Name == pipeline.TableCheckFreshness && len(res) == 1 && len(res[0]) == 1 && res[0][0] == nil {
+		return 0, errors.Errorf("column '%s' has no values to check the freshness of", ti.Check.Column)
+	}
+
+	value, err := helpers.CastResultToInteger(res, false)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse '%s' check result", ti.Check.Name)
+	}
+	ti.Observed = &value
+
+	return value, nil
+}
+
+func formatRowCountChange(previous, current int64, change float64) string {
+	direction := "an increase"
+	if current < previous {
+		direction = "a decrease"
+	}
+
+	if math.IsInf(change, 1) {
+		return direction + " from zero"
+	}
+
+	return fmt.Sprintf("%s of %s%%", direction, formatPercentage(change))
+}
+
+func formatPercentage(p float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", p), "0"), ".")
+}
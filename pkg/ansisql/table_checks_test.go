@@ -0,0 +1,313 @@
+package ansisql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/bruin-data/bruin/pkg/runhistory"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTableCheckHistory struct {
+	values map[string]int64
+}
+
+func (m *mockTableCheckHistory) LastObservedValue(taskID string) (int64, bool, error) {
+	v, ok := m.values[taskID]
+	return v, ok, nil
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestTableCheckOperator_Run(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		assetType     pipeline.AssetType
+		check         pipeline.TableCheck
+		history       map[string]int64
+		expectedQuery string
+		result        [][]interface{}
+		wantErr       string
+		wantWarning   string
+		wantObserved  *int64
+	}{
+		{
+			name:          "row count within bounds",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCount, Min: int64Ptr(1), Max: int64Ptr(100)},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(50)}},
+			wantObserved:  int64Ptr(50),
+		},
+		{
+			name:          "row count below the minimum",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCount, Min: int64Ptr(1)},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(0)}},
+			wantErr:       "table 'dataset.orders' has 0 rows, below the minimum of 1",
+		},
+		{
+			name:          "row count above the maximum",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCount, Max: int64Ptr(10)},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{"11"}},
+			wantErr:       "table 'dataset.orders' has 11 rows, above the maximum of 10",
+		},
+		{
+			name:          "row count below the minimum with the warn severity",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCount, Min: int64Ptr(1), Severity: pipeline.CheckSeverityWarn},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(0)}},
+			wantWarning:   "table 'dataset.orders' has 0 rows, below the minimum of 1",
+			wantObserved:  int64Ptr(0),
+		},
+		{
+			name:          "row count matching warn_if",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCount, Min: int64Ptr(1), WarnIf: "<100"},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(50)}},
+			wantWarning:   "the 'row_count' check of table 'dataset.orders' measured 50, matching its threshold '<100'",
+			wantObserved:  int64Ptr(50),
+		},
+		{
+			name:          "row count matching error_if",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCount, ErrorIf: ">1000"},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(5000)}},
+			wantErr:       "the 'row_count' check of table 'dataset.orders' measured 5000, matching its threshold '>1000'",
+		},
+		{
+			name:          "fresh table on bigquery",
+			assetType:     pipeline.AssetTypeBigqueryQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckFreshness, Column: "updated_at", MaxAge: "1h"},
+			expectedQuery: "SELECT TIMESTAMP_DIFF(CURRENT_TIMESTAMP(), CAST(MAX(updated_at) AS TIMESTAMP), SECOND) FROM dataset.orders",
+			result:        [][]interface{}{{int64(60)}},
+			wantObserved:  int64Ptr(60),
+		},
+		{
+			name:          "python asset checked on the platform of the pipeline",
+			assetType:     pipeline.AssetTypePython,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckFreshness, Column: "updated_at", MaxAge: "1h"},
+			expectedQuery: "SELECT DATEDIFF('second', MAX(updated_at), CURRENT_TIMESTAMP()) FROM dataset.orders",
+			result:        [][]interface{}{{int64(60)}},
+			wantObserved:  int64Ptr(60),
+		},
+		{
+			name:          "stale table on postgres",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckFreshness, Column: "updated_at", MaxAge: "1d"},
+			expectedQuery: "SELECT CAST(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - MAX(updated_at))) AS BIGINT) FROM dataset.orders",
+			result:        [][]interface{}{{float64(90000)}},
+			wantErr:       "the latest value of column 'updated_at' is 25h0m0s old, older than the maximum age of 1d",
+		},
+		{
+			name:          "stale table below error_if only warns",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckFreshness, Column: "updated_at", MaxAge: "1d", ErrorIf: ">172800"},
+			expectedQuery: "SELECT CAST(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - MAX(updated_at))) AS BIGINT) FROM dataset.orders",
+			result:        [][]interface{}{{float64(90000)}},
+			wantWarning:   "the latest value of column 'updated_at' is 25h0m0s old, older than the maximum age of 1d",
+			wantObserved:  int64Ptr(90000),
+		},
+		{
+			name:          "freshness of an empty table",
+			assetType:     pipeline.AssetTypeSnowflakeQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckFreshness, Column: "updated_at", MaxAge: "1d"},
+			expectedQuery: "SELECT DATEDIFF('second', MAX(updated_at), CURRENT_TIMESTAMP()) FROM dataset.orders",
+			result:        [][]interface{}{{nil}},
+			wantErr:       "column 'updated_at' has no values to check the freshness of",
+		},
+		{
+			name:      "freshness with an invalid max age",
+			assetType: pipeline.AssetTypeSnowflakeQuery,
+			check:     pipeline.TableCheck{Name: pipeline.TableCheckFreshness, Column: "updated_at", MaxAge: "soon"},
+			wantErr:   "invalid 'max_age' for the freshness check of column 'updated_at'",
+		},
+		{
+			name:          "first run of the row count change check",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCountChange, MaxChange: float64Ptr(10)},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(1000)}},
+			wantObserved:  int64Ptr(1000),
+		},
+		{
+			name:          "row count change within the limit",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCountChange, MaxChange: float64Ptr(10)},
+			history:       map[string]int64{"dataset.orders:table-check:row_count_change": 1000},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(1100)}},
+			wantObserved:  int64Ptr(1100),
+		},
+		{
+			name:          "row count dropped more than allowed",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCountChange, MaxChange: float64Ptr(10)},
+			history:       map[string]int64{"dataset.orders:table-check:row_count_change": 1000},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(850)}},
+			wantErr:       "table 'dataset.orders' has 850 rows, a decrease of 15% compared to 1000 rows in the previous run, more than the allowed 10%",
+		},
+		{
+			name:          "row count dropped more than allowed but below error_if",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCountChange, MaxChange: float64Ptr(10), ErrorIf: ">20"},
+			history:       map[string]int64{"dataset.orders:table-check:row_count_change": 1000},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(850)}},
+			wantWarning:   "table 'dataset.orders' has 850 rows, a decrease of 15% compared to 1000 rows in the previous run, more than the allowed 10%",
+			wantObserved:  int64Ptr(850),
+		},
+		{
+			name:          "rows appeared in an empty table",
+			assetType:     pipeline.AssetTypePostgresQuery,
+			check:         pipeline.TableCheck{Name: pipeline.TableCheckRowCountChange, MaxChange: float64Ptr(50)},
+			history:       map[string]int64{"dataset.orders:table-check:row_count_change": 0},
+			expectedQuery: "SELECT COUNT(*) FROM dataset.orders",
+			result:        [][]interface{}{{int64(5)}},
+			wantErr:       "table 'dataset.orders' has 5 rows, an increase from zero compared to 0 rows in the previous run, more than the allowed 50%",
+		},
+		{
+			name:      "row count change without a limit",
+			assetType: pipeline.AssetTypePostgresQuery,
+			check:     pipeline.TableCheck{Name: pipeline.TableCheckRowCountChange},
+			wantErr:   "row_count_change check requires a 'max_change' percentage to be set",
+		},
+		{
+			name:      "unknown check",
+			assetType: pipeline.AssetTypePostgresQuery,
+			check:     pipeline.TableCheck{Name: "row_size"},
+			wantErr:   "unknown table check 'row_size'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := new(mockQuerierWithResult)
+			if tt.expectedQuery != "" {
+				q.On("Select", mock.Anything, &query.Query{Query: tt.expectedQuery}).Return(tt.result, nil).Once()
+			}
+
+			conn := new(mockConnectionFetcher)
+			conn.On("GetConnection", "test").Return(q)
+
+			asset := &pipeline.Asset{Name: "dataset.orders", Type: tt.assetType, Connection: "test"}
+			instance := &scheduler.TableCheckInstance{
+				AssetInstance: &scheduler.AssetInstance{
+					HumanID:  "dataset.orders:table-check:" + tt.check.HumanName(),
+					Asset:    asset,
+					Pipeline: &pipeline.Pipeline{Name: "test"},
+				},
+				Check: &tt.check,
+			}
+
+			operator := NewTableCheckOperator(conn, &mockTableCheckHistory{values: tt.history}, pipeline.AssetTypeSnowflakeQuery)
+			err := operator.Run(context.Background(), instance)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantObserved, instance.Observed)
+				assert.Equal(t, tt.expectedQuery, instance.ExecutedQuery)
+				if tt.wantWarning != "" {
+					require.Error(t, instance.Warning)
+					assert.Equal(t, tt.wantWarning, instance.Warning.Error())
+				} else {
+					require.NoError(t, instance.Warning)
+				}
+			}
+
+			q.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTableCheckOperator_RowCountChangeAfterFailedRun(t *testing.T) {
+	t.Parallel()
+
+	const taskID = "dataset.orders:table-check:row_count_change"
+	store := runhistory.NewStore(afero.NewMemMapFs(), "/repo/logs/runs/history.jsonl")
+	record := func(runID string, instance *scheduler.TableCheckInstance, err error) {
+		status := scheduler.Succeeded.String()
+		if err != nil {
+			status = scheduler.Failed.String()
+		}
+		require.NoError(t, store.Record(&runhistory.Run{
+			RunID: runID,
+			Tasks: []*runhistory.Task{{ID: taskID, Status: status, Check: &runhistory.Check{Name: "row_count_change", Observed: instance.Observed}}},
+		}))
+	}
+
+	run := func(count int64) (*scheduler.TableCheckInstance, error) {
+		q := new(mockQuerierWithResult)
+		q.On("Select", mock.Anything, &query.Query{Query: "SELECT COUNT(*) FROM dataset.orders"}).Return([][]interface{}{{count}}, nil).Once()
+		conn := new(mockConnectionFetcher)
+		conn.On("GetConnection", "test").Return(q)
+
+		instance := &scheduler.TableCheckInstance{
+			AssetInstance: &scheduler.AssetInstance{
+				HumanID:  taskID,
+				Asset:    &pipeline.Asset{Name: "dataset.orders", Type: pipeline.AssetTypePostgresQuery, Connection: "test"},
+				Pipeline: &pipeline.Pipeline{Name: "test"},
+			},
+			Check: &pipeline.TableCheck{Name: pipeline.TableCheckRowCountChange, MaxChange: float64Ptr(10)},
+		}
+
+		return instance, NewTableCheckOperator(conn, store, "").Run(context.Background(), instance)
+	}
+
+	instance, err := run(1000)
+	require.NoError(t, err)
+	record("run-1", instance, err)
+
+	// the volume doubles, the check reports it once
+	instance, err = run(2000)
+	require.Error(t, err)
+	record("run-2", instance, err)
+
+	// the volume stays at the new level, it is compared to the failed run rather than to the first one
+	instance, err = run(2050)
+	require.NoError(t, err)
+	record("run-3", instance, err)
+}
+
+func TestFreshnessQuery(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"SELECT DATEDIFF(SECOND, MAX(ts), CURRENT_TIMESTAMP) FROM dbo.events",
+		FreshnessQuery(pipeline.AssetTypeMsSQLQuery, "dbo.events", "ts"),
+	)
+	assert.Equal(t,
+		"SELECT dateDiff('second', MAX(ts), now()) FROM db.events",
+		FreshnessQuery(pipeline.AssetTypeClickHouse, "db.events", "ts"),
+	)
+	// python assets and the platforms without an override fall back to ANSI SQL
+	assert.Equal(t,
+		"SELECT CAST(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - MAX(ts))) AS BIGINT) FROM public.events",
+		FreshnessQuery(pipeline.AssetTypePython, "public.events", "ts"),
+	)
+}
@@ -90,6 +90,16 @@ func AddCustomCheckAnnotationComment(ctx context.Context, q *query.Query, assetN
 	})
 }
 
+func AddTableCheckAnnotationComment(ctx context.Context, q *query.Query, assetName, checkName, pipelineName string) (*query.Query, error) {
+	return prependAnnotationComment(ctx, q, map[string]interface{}{
+		"asset":            assetName,
+		"asset_name":       assetName,
+		"type":             "table_check",
+		"table_check_type": checkName,
+		"pipeline":         pipelineName,
+	})
+}
+
 // AddAgentIDAnnotationComment adds an agent ID annotation comment to the query.
 // This is used for adhoc queries to track which agent executed them.
 // The comment is prepended to the beginning of the query (works for BigQuery and others).
@@ -2,6 +2,8 @@ package athena
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/bruin-data/bruin/pkg/ansisql"
@@ -152,26 +154,23 @@ func NewColumnCheckOperator(manager config.ConnectionGetter) *ansisql.ColumnChec
 		"negative":        ansisql.NewNegativeCheck(manager),
 		"min":             ansisql.NewMinCheck(manager),
 		"max":             ansisql.NewMaxCheck(manager),
+		"relationships":   ansisql.NewRelationshipsCheck(manager),
 		"accepted_values": &AcceptedValuesCheck{conn: manager},
 		"pattern":         &PatternCheck{conn: manager},
 	})
 }
 
-type renderer interface {
-	Render(query string) (string, error)
-}
-
 type QuerySensor struct {
-	connection     config.ConnectionGetter
-	renderer       renderer
-	secondsToSleep int64
+	connection config.ConnectionGetter
+	extractor  query.QueryExtractor
+	sensorMode string
 }
 
-func NewQuerySensor(conn config.ConnectionGetter, renderer renderer, secondsToSleep int64) *QuerySensor {
+func NewQuerySensor(conn config.ConnectionGetter, extractor query.QueryExtractor, sensorMode string) *QuerySensor {
 	return &QuerySensor{
-		connection:     conn,
-		renderer:       renderer,
-		secondsToSleep: secondsToSleep,
+		connection: conn,
+		extractor:  extractor,
+		sensorMode: sensorMode,
 	}
 }
 
@@ -180,15 +179,25 @@ func (o *QuerySensor) Run(ctx context.Context, ti scheduler.TaskInstance) error
 }
 
 func (o *QuerySensor) RunTask(ctx context.Context, p *pipeline.Pipeline, t *pipeline.Asset) error {
+	if o.sensorMode == "skip" {
+		return nil
+	}
 	qq, ok := t.Parameters["query"]
 	if !ok {
 		return errors.New("query sensor requires a parameter named 'query'")
 	}
+	extractor, err := o.extractor.CloneForAsset(ctx, p, t)
+	if err != nil {
+		return errors.Wrapf(err, "failed to clone extractor for asset %s", t.Name)
+	}
 
-	qq, err := o.renderer.Render(qq)
+	qry, err := extractor.ExtractQueriesFromString(qq)
 	if err != nil {
 		return errors.Wrap(err, "failed to render query sensor query")
 	}
+	if len(qry) == 0 {
+		return errors.New("query sensor requires a non-empty 'query' parameter")
+	}
 
 	connName, err := p.GetConnectionNameForAsset(t)
 	if err != nil {
@@ -205,23 +214,39 @@ func (o *QuerySensor) RunTask(ctx context.Context, p *pipeline.Pipeline, t *pipe
 		return errors.Errorf("connection '%s' is not an athena connection", connName)
 	}
 
+	printer, printerExists := ctx.Value(executor.KeyPrinter).(io.Writer)
+	if printerExists {
+		fmt.Fprintln(printer, "Poking:", qry[0].Query)
+	}
+
+	sensorTimeout := helpers.GetSensorTimeout(t)
+	timeout := time.After(sensorTimeout)
 	for {
-		res, err := conn.Select(ctx, &query.Query{Query: qq})
-		if err != nil {
-			return err
-		}
+		select {
+		case <-timeout:
+			return errors.Errorf("Sensor timed out after %s", sensorTimeout)
+		default:
+			res, err := conn.Select(ctx, qry[0])
+			if err != nil {
+				return err
+			}
+			intRes, err := helpers.CastResultToInteger(res, true)
+			if err != nil {
+				return errors.Wrap(err, "failed to parse query sensor result")
+			}
 
-		intRes, err := helpers.CastResultToInteger(res, true)
-		if err != nil {
-			return errors.Wrap(err, "failed to parse query sensor result")
-		}
+			if intRes > 0 {
+				return nil
+			}
+			if o.sensorMode == "once" || o.sensorMode == "" {
+				return errors.New("Sensor didn't return the expected result")
+			}
 
-		if intRes > 0 {
-			break
+			pokeInterval := helpers.GetPokeInterval(ctx, t)
+			time.Sleep(time.Duration(pokeInterval) * time.Second)
+			if printerExists {
+				fmt.Fprintln(printer, "Info: Sensor didn't return the expected result, waiting for", pokeInterval, "seconds")
+			}
 		}
-
-		time.Sleep(time.Duration(o.secondsToSleep) * time.Second)
 	}
-
-	return nil
 }
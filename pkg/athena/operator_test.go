@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type mockExtractor struct {
@@ -227,3 +228,128 @@ func TestBasicOperator_RunTask(t *testing.T) {
 		})
 	}
 }
+
+func TestQuerySensor_RunTask(t *testing.T) {
+	t.Parallel()
+
+	sensorQuery := &query.Query{Query: "SELECT COUNT(*) FROM orders"}
+
+	tests := []struct {
+		name           string
+		sensorMode     string
+		params         map[string]string
+		setupQueries   func(m *mockQuerierWithResult)
+		setupExtractor func(m *mockExtractor)
+		connection     any
+		wantErr        string
+	}{
+		{
+			name:       "skip mode does not run the query",
+			sensorMode: "skip",
+			params:     map[string]string{"query": "SELECT COUNT(*) FROM orders"},
+		},
+		{
+			name:       "missing query parameter",
+			sensorMode: "once",
+			params:     map[string]string{},
+			wantErr:    "query sensor requires a parameter named 'query'",
+		},
+		{
+			name:       "empty query",
+			sensorMode: "once",
+			params:     map[string]string{"query": ""},
+			setupExtractor: func(m *mockExtractor) {
+				m.On("ExtractQueriesFromString", "").Return([]*query.Query{}, nil)
+			},
+			wantErr: "query sensor requires a non-empty 'query' parameter",
+		},
+		{
+			name:       "connection is not an athena connection",
+			sensorMode: "once",
+			params:     map[string]string{"query": "SELECT COUNT(*) FROM orders"},
+			setupExtractor: func(m *mockExtractor) {
+				m.On("ExtractQueriesFromString", "SELECT COUNT(*) FROM orders").Return([]*query.Query{sensorQuery}, nil)
+			},
+			connection: "not-a-client",
+			wantErr:    "connection 'athena-default' is not an athena connection",
+		},
+		{
+			name:       "once mode fails when the query returns zero",
+			sensorMode: "once",
+			params:     map[string]string{"query": "SELECT COUNT(*) FROM orders"},
+			setupExtractor: func(m *mockExtractor) {
+				m.On("ExtractQueriesFromString", "SELECT COUNT(*) FROM orders").Return([]*query.Query{sensorQuery}, nil)
+			},
+			setupQueries: func(m *mockQuerierWithResult) {
+				m.On("Select", mock.Anything, sensorQuery).Return([][]interface{}{{int64(0)}}, nil)
+			},
+			wantErr: "Sensor didn't return the expected result",
+		},
+		{
+			name:       "once mode succeeds when the query returns a positive value",
+			sensorMode: "once",
+			params:     map[string]string{"query": "SELECT COUNT(*) FROM orders"},
+			setupExtractor: func(m *mockExtractor) {
+				m.On("ExtractQueriesFromString", "SELECT COUNT(*) FROM orders").Return([]*query.Query{sensorQuery}, nil)
+			},
+			setupQueries: func(m *mockQuerierWithResult) {
+				m.On("Select", mock.Anything, sensorQuery).Return([][]interface{}{{int64(3)}}, nil)
+			},
+		},
+		{
+			name:       "query errors are propagated",
+			sensorMode: "wait",
+			params:     map[string]string{"query": "SELECT COUNT(*) FROM orders"},
+			setupExtractor: func(m *mockExtractor) {
+				m.On("ExtractQueriesFromString", "SELECT COUNT(*) FROM orders").Return([]*query.Query{sensorQuery}, nil)
+			},
+			setupQueries: func(m *mockQuerierWithResult) {
+				m.On("Select", mock.Anything, sensorQuery).Return(nil, errors.New("table not found"))
+			},
+			wantErr: "table not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := new(mockQuerierWithResult)
+			if tt.setupQueries != nil {
+				tt.setupQueries(client)
+			}
+
+			extractor := new(mockExtractor)
+			if tt.setupExtractor != nil {
+				tt.setupExtractor(extractor)
+			}
+
+			var conn any = client
+			if tt.connection != nil {
+				conn = tt.connection
+			}
+			fetcher := new(mockConnectionFetcher)
+			fetcher.On("GetConnection", "athena-default").Return(conn)
+
+			p := &pipeline.Pipeline{
+				DefaultConnections: map[string]string{"athena": "athena-default"},
+			}
+			asset := &pipeline.Asset{
+				Name:       "orders_sensor",
+				Type:       pipeline.AssetTypeAthenaSQLSensor,
+				Parameters: tt.params,
+			}
+
+			o := NewQuerySensor(fetcher, extractor, tt.sensorMode)
+			err := o.RunTask(context.Background(), p, asset)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			client.AssertExpectations(t)
+			extractor.AssertExpectations(t)
+		})
+	}
+}
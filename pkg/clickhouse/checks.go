@@ -77,6 +77,7 @@ func NewColumnCheckOperator(manager config.ConnectionGetter) *ansisql.ColumnChec
 		"negative":        ansisql.NewNegativeCheck(manager),
 		"min":             ansisql.NewMinCheck(manager),
 		"max":             ansisql.NewMaxCheck(manager),
+		"relationships":   ansisql.NewRelationshipsCheck(manager),
 		"accepted_values": &AcceptedValuesCheck{conn: manager},
 		"pattern":         &PatternCheck{conn: manager},
 	})
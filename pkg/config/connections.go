@@ -31,6 +31,7 @@ type GoogleCloudPlatformConnection struct { //nolint:recvcheck
 	ProjectID                        string `yaml:"project_id,omitempty" json:"project_id" mapstructure:"project_id"`
 	Location                         string `yaml:"location,omitempty" json:"location,omitempty" mapstructure:"location"`
 	UseApplicationDefaultCredentials bool   `yaml:"use_application_default_credentials,omitempty" json:"use_application_default_credentials,omitempty" mapstructure:"use_application_default_credentials"`
+	MaxConcurrency                   int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 	rawCredentials                   *google.Credentials
 }
 
@@ -38,6 +39,10 @@ func (c GoogleCloudPlatformConnection) GetName() string {
 	return c.Name
 }
 
+func (c GoogleCloudPlatformConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 func (c GoogleCloudPlatformConnection) MarshalYAML() (interface{}, error) {
 	m := make(map[string]interface{})
 
@@ -55,6 +60,10 @@ func (c GoogleCloudPlatformConnection) MarshalYAML() (interface{}, error) {
 		m["use_application_default_credentials"] = c.UseApplicationDefaultCredentials
 	}
 
+	if c.MaxConcurrency > 0 {
+		m["max_concurrency"] = c.MaxConcurrency
+	}
+
 	// Include only one of ServiceAccountJSON or ServiceAccountFile, whichever is not empty
 	if c.ServiceAccountFile != "" {
 		m["service_account_file"] = c.ServiceAccountFile
@@ -101,6 +110,7 @@ type AthenaConnection struct { //nolint:recvcheck
 	Region           string `yaml:"region,omitempty" json:"region" mapstructure:"region"`
 	Database         string `yaml:"database,omitempty" json:"database,omitempty" mapstructure:"database"`
 	Profile          string `yaml:"profile,omitempty" json:"profile,omitempty" mapstructure:"profile"`
+	MaxConcurrency   int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 
 	regionSetFromProfile bool
 }
@@ -109,6 +119,10 @@ func (c AthenaConnection) GetName() string {
 	return c.Name
 }
 
+func (c AthenaConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 func (c *AthenaConnection) LoadCredentialsFromProfile(ctx context.Context) error {
 	cfg, err := config.LoadDefaultConfig(ctx,
 		config.WithSharedConfigProfile(c.Profile),
@@ -152,6 +166,10 @@ func (c AthenaConnection) MarshalYAML() (interface{}, error) {
 		m["region"] = c.Region
 	}
 
+	if c.MaxConcurrency > 0 {
+		m["max_concurrency"] = c.MaxConcurrency
+	}
+
 	if c.Profile != "" {
 		m["profile"] = c.Profile
 		return m, nil
@@ -169,19 +187,24 @@ func (c AthenaConnection) MarshalYAML() (interface{}, error) {
 }
 
 type SynapseConnection struct {
-	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Username string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
-	Password string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
-	Host     string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
-	Port     int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=1433"`
-	Database string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
-	Options  string `yaml:"options,omitempty"  json:"options,omitempty" mapstructure:"options"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=1433"`
+	Database       string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
+	Options        string `yaml:"options,omitempty"  json:"options,omitempty" mapstructure:"options"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c SynapseConnection) GetName() string {
 	return c.Name
 }
 
+func (c SynapseConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type FabricConnection struct {
 	Name                      string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
 	Host                      string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
@@ -194,28 +217,38 @@ type FabricConnection struct {
 	ClientID                  string `yaml:"client_id,omitempty" json:"client_id,omitempty" mapstructure:"client_id"`
 	ClientSecret              string `yaml:"client_secret,omitempty" json:"client_secret,omitempty" mapstructure:"client_secret"`
 	TenantID                  string `yaml:"tenant_id,omitempty" json:"tenant_id,omitempty" mapstructure:"tenant_id"`
+	MaxConcurrency            int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c FabricConnection) GetName() string {
 	return c.Name
 }
 
+func (c FabricConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type DatabricksConnection struct {
-	Name         string `yaml:"name,omitempty"  json:"name" mapstructure:"name"`
-	Token        string `yaml:"token,omitempty" json:"token,omitempty" mapstructure:"token" jsonschema:"oneof_required=token"`
-	Path         string `yaml:"path,omitempty"  json:"path" mapstructure:"path"`
-	Host         string `yaml:"host,omitempty"  json:"host" mapstructure:"host"`
-	Port         int    `yaml:"port,omitempty"  json:"port" mapstructure:"port" jsonschema:"default=443"`
-	Catalog      string `yaml:"catalog,omitempty"  json:"catalog" mapstructure:"catalog"`
-	Schema       string `yaml:"schema,omitempty"  json:"schema" mapstructure:"schema"`
-	ClientID     string `yaml:"client_id,omitempty" json:"client_id,omitempty" mapstructure:"client_id" jsonschema:"oneof_required=oauth"`
-	ClientSecret string `yaml:"client_secret,omitempty" json:"client_secret,omitempty" mapstructure:"client_secret" jsonschema:"oneof_required=oauth"`
+	Name           string `yaml:"name,omitempty"  json:"name" mapstructure:"name"`
+	Token          string `yaml:"token,omitempty" json:"token,omitempty" mapstructure:"token" jsonschema:"oneof_required=token"`
+	Path           string `yaml:"path,omitempty"  json:"path" mapstructure:"path"`
+	Host           string `yaml:"host,omitempty"  json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port,omitempty"  json:"port" mapstructure:"port" jsonschema:"default=443"`
+	Catalog        string `yaml:"catalog,omitempty"  json:"catalog" mapstructure:"catalog"`
+	Schema         string `yaml:"schema,omitempty"  json:"schema" mapstructure:"schema"`
+	ClientID       string `yaml:"client_id,omitempty" json:"client_id,omitempty" mapstructure:"client_id" jsonschema:"oneof_required=oauth"`
+	ClientSecret   string `yaml:"client_secret,omitempty" json:"client_secret,omitempty" mapstructure:"client_secret" jsonschema:"oneof_required=oauth"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c DatabricksConnection) GetName() string {
 	return c.Name
 }
 
+func (c DatabricksConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type MongoConnection struct {
 	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
 	Username string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
@@ -264,67 +297,87 @@ func (c MongoAtlasConnection) GetName() string {
 }
 
 type MsSQLConnection struct {
-	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Username string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
-	Password string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
-	Host     string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
-	Port     int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=1433"`
-	Database string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
-	Options  string `yaml:"options,omitempty"  json:"options,omitempty" mapstructure:"options"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=1433"`
+	Database       string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
+	Options        string `yaml:"options,omitempty"  json:"options,omitempty" mapstructure:"options"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c MsSQLConnection) GetName() string {
 	return c.Name
 }
 
+func (c MsSQLConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type MySQLConnection struct {
-	Name        string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Username    string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
-	Password    string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
-	Host        string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
-	Port        int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=3306"`
-	Database    string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
-	Driver      string `yaml:"driver,omitempty" json:"driver,omitempty" mapstructure:"driver"`
-	SslCaPath   string `yaml:"ssl_ca_path,omitempty" json:"ssl_ca_path,omitempty" mapstructure:"ssl_ca_path"`
-	SslCertPath string `yaml:"ssl_cert_path,omitempty" json:"ssl_cert_path,omitempty" mapstructure:"ssl_cert_path"`
-	SslKeyPath  string `yaml:"ssl_key_path,omitempty" json:"ssl_key_path,omitempty" mapstructure:"ssl_key_path"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=3306"`
+	Database       string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
+	Driver         string `yaml:"driver,omitempty" json:"driver,omitempty" mapstructure:"driver"`
+	SslCaPath      string `yaml:"ssl_ca_path,omitempty" json:"ssl_ca_path,omitempty" mapstructure:"ssl_ca_path"`
+	SslCertPath    string `yaml:"ssl_cert_path,omitempty" json:"ssl_cert_path,omitempty" mapstructure:"ssl_cert_path"`
+	SslKeyPath     string `yaml:"ssl_key_path,omitempty" json:"ssl_key_path,omitempty" mapstructure:"ssl_key_path"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c MySQLConnection) GetName() string {
 	return c.Name
 }
 
+func (c MySQLConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type PostgresConnection struct {
-	Name         string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Username     string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
-	Password     string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
-	Host         string `yaml:"host,omitempty" json:"host" mapstructure:"host"`
-	Port         int    `yaml:"port,omitempty" json:"port" mapstructure:"port" jsonschema:"default=5432"`
-	Database     string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
-	Schema       string `yaml:"schema,omitempty" json:"schema,omitempty" mapstructure:"schema"`
-	PoolMaxConns int    `yaml:"pool_max_conns,omitempty" json:"pool_max_conns,omitempty" mapstructure:"pool_max_conns" default:"10"`
-	SslMode      string `yaml:"ssl_mode,omitempty" json:"ssl_mode,omitempty" mapstructure:"ssl_mode" default:"allow"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host,omitempty" json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port,omitempty" json:"port" mapstructure:"port" jsonschema:"default=5432"`
+	Database       string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
+	Schema         string `yaml:"schema,omitempty" json:"schema,omitempty" mapstructure:"schema"`
+	PoolMaxConns   int    `yaml:"pool_max_conns,omitempty" json:"pool_max_conns,omitempty" mapstructure:"pool_max_conns" default:"10"`
+	SslMode        string `yaml:"ssl_mode,omitempty" json:"ssl_mode,omitempty" mapstructure:"ssl_mode" default:"allow"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c PostgresConnection) GetName() string {
 	return c.Name
 }
 
+func (c PostgresConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type RedshiftConnection struct {
-	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Username string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
-	Password string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
-	Host     string `yaml:"host,omitempty" json:"host" mapstructure:"host"`
-	Port     int    `yaml:"port,omitempty" json:"port" mapstructure:"port" jsonschema:"default=5439"`
-	Database string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
-	Schema   string `yaml:"schema,omitempty" json:"schema" mapstructure:"schema"`
-	SslMode  string `yaml:"ssl_mode,omitempty" json:"ssl_mode,omitempty" mapstructure:"ssl_mode" default:"allow"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host,omitempty" json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port,omitempty" json:"port" mapstructure:"port" jsonschema:"default=5439"`
+	Database       string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
+	Schema         string `yaml:"schema,omitempty" json:"schema" mapstructure:"schema"`
+	SslMode        string `yaml:"ssl_mode,omitempty" json:"ssl_mode,omitempty" mapstructure:"ssl_mode" default:"allow"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c RedshiftConnection) GetName() string {
 	return c.Name
 }
 
+func (c RedshiftConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type SnowflakeConnection struct {
 	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
 	Account        string `yaml:"account,omitempty" json:"account" mapstructure:"account"`
@@ -337,6 +390,7 @@ type SnowflakeConnection struct {
 	Warehouse      string `yaml:"warehouse,omitempty" json:"warehouse,omitempty" mapstructure:"warehouse"`
 	PrivateKeyPath string `yaml:"private_key_path,omitempty" json:"private_key_path,omitempty" jsonschema:"oneof_required=private_key_path" mapstructure:"private_key_path"`
 	PrivateKey     string `yaml:"private_key,omitempty" json:"private_key,omitempty" jsonschema:"oneof_required=private_key" mapstructure:"private_key"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c SnowflakeConnection) MarshalJSON() ([]byte, error) {
@@ -371,6 +425,10 @@ func (c SnowflakeConnection) GetName() string {
 	return c.Name
 }
 
+func (c SnowflakeConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 // MarshalYAML implements custom YAML marshaling for SnowflakeConnection.
 // This ensures that the private_key field is written using YAML literal block scalar style (|)
 // to preserve newlines in the PEM-formatted private key.
@@ -431,6 +489,12 @@ func (c SnowflakeConnection) MarshalYAML() (interface{}, error) {
 			&yaml.Node{Kind: yaml.ScalarNode, Value: c.Warehouse},
 		)
 	}
+	if c.MaxConcurrency > 0 {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "max_concurrency"},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(c.MaxConcurrency)},
+		)
+	}
 	if c.PrivateKeyPath != "" && c.PrivateKey == "" {
 		node.Content = append(node.Content,
 			&yaml.Node{Kind: yaml.ScalarNode, Value: "private_key_path"},
@@ -708,41 +772,56 @@ func (c AirtableConnection) GetName() string {
 }
 
 type DuckDBConnection struct {
-	Name      string           `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Path      string           `yaml:"path,omitempty" json:"path" mapstructure:"path"`
-	ReadOnly  bool             `yaml:"read_only,omitempty" json:"read_only,omitempty" mapstructure:"read_only"`
-	Lakehouse *LakehouseConfig `yaml:"lakehouse,omitempty" json:"lakehouse,omitempty" mapstructure:"lakehouse"`
+	Name           string           `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Path           string           `yaml:"path,omitempty" json:"path" mapstructure:"path"`
+	ReadOnly       bool             `yaml:"read_only,omitempty" json:"read_only,omitempty" mapstructure:"read_only"`
+	Lakehouse      *LakehouseConfig `yaml:"lakehouse,omitempty" json:"lakehouse,omitempty" mapstructure:"lakehouse"`
+	MaxConcurrency int              `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (d DuckDBConnection) GetName() string {
 	return d.Name
 }
 
+func (d DuckDBConnection) GetMaxConcurrency() int {
+	return d.MaxConcurrency
+}
+
 type MotherduckConnection struct {
-	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Token    string `yaml:"token,omitempty" json:"token" mapstructure:"token"`
-	Database string `yaml:"database,omitempty" json:"database,omitempty" mapstructure:"database"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Token          string `yaml:"token,omitempty" json:"token" mapstructure:"token"`
+	Database       string `yaml:"database,omitempty" json:"database,omitempty" mapstructure:"database"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (m MotherduckConnection) GetName() string {
 	return m.Name
 }
 
+func (m MotherduckConnection) GetMaxConcurrency() int {
+	return m.MaxConcurrency
+}
+
 type ClickHouseConnection struct {
-	Name     string `yaml:"name" json:"name" mapstructure:"name"`
-	Username string `yaml:"username" json:"username" mapstructure:"username"`
-	Password string `yaml:"password" json:"password" mapstructure:"password"`
-	Host     string `yaml:"host"     json:"host" mapstructure:"host"`
-	Port     int    `yaml:"port"     json:"port" mapstructure:"port"`
-	Database string `yaml:"database" json:"database" mapstructure:"database"`
-	HTTPPort int    `yaml:"http_port,omitempty" json:"http_port,omitempty" mapstructure:"http_port"`
-	Secure   *int   `yaml:"secure,omitempty" json:"secure,omitempty" mapstructure:"secure"`
+	Name           string `yaml:"name" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host"     json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port"     json:"port" mapstructure:"port"`
+	Database       string `yaml:"database" json:"database" mapstructure:"database"`
+	HTTPPort       int    `yaml:"http_port,omitempty" json:"http_port,omitempty" mapstructure:"http_port"`
+	Secure         *int   `yaml:"secure,omitempty" json:"secure,omitempty" mapstructure:"secure"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c ClickHouseConnection) GetName() string {
 	return c.Name
 }
 
+func (c ClickHouseConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type AppsflyerConnection struct {
 	Name   string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
 	APIKey string `yaml:"api_key,omitempty" json:"api_key" mapstructure:"api_key"`
@@ -850,6 +929,35 @@ func (c SlackConnection) GetName() string {
 	return c.Name
 }
 
+type MSTeamsConnection struct {
+	Name       string `yaml:"name" json:"name" mapstructure:"name"`
+	WebhookURL string `yaml:"webhook_url" json:"webhook_url" mapstructure:"webhook_url"`
+}
+
+func (c MSTeamsConnection) GetName() string {
+	return c.Name
+}
+
+type DiscordConnection struct {
+	Name       string `yaml:"name" json:"name" mapstructure:"name"`
+	WebhookURL string `yaml:"webhook_url" json:"webhook_url" mapstructure:"webhook_url"`
+}
+
+func (c DiscordConnection) GetName() string {
+	return c.Name
+}
+
+type WebhookConnection struct {
+	Name     string `yaml:"name" json:"name" mapstructure:"name"`
+	URL      string `yaml:"url" json:"url" mapstructure:"url"`
+	Username string `yaml:"username,omitempty" json:"username,omitempty" mapstructure:"username"`
+	Password string `yaml:"password,omitempty" json:"password,omitempty" mapstructure:"password"`
+}
+
+func (c WebhookConnection) GetName() string {
+	return c.Name
+}
+
 type SocrataConnection struct {
 	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
 	Domain   string `yaml:"domain,omitempty" json:"domain" mapstructure:"domain"`
@@ -1245,25 +1353,30 @@ func (c DB2Connection) GetName() string {
 }
 
 type OracleConnection struct {
-	Name         string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Username     string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
-	Password     string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
-	Host         string `yaml:"host,omitempty" json:"host" mapstructure:"host"`
-	Port         string `yaml:"port,omitempty" json:"port" mapstructure:"port"`
-	ServiceName  string `yaml:"service_name,omitempty" json:"service_name" mapstructure:"service_name"`
-	SID          string `yaml:"sid,omitempty" json:"sid" mapstructure:"sid"`
-	Role         string `yaml:"role,omitempty" json:"role" mapstructure:"role"`
-	SSL          bool   `yaml:"ssl,omitempty" json:"ssl" mapstructure:"ssl"`
-	SSLVerify    bool   `yaml:"ssl_verify,omitempty" json:"ssl_verify" mapstructure:"ssl_verify"`
-	PrefetchRows int    `yaml:"prefetch_rows,omitempty" json:"prefetch_rows" mapstructure:"prefetch_rows"`
-	TraceFile    string `yaml:"trace_file,omitempty" json:"trace_file" mapstructure:"trace_file"`
-	Wallet       string `yaml:"wallet,omitempty" json:"wallet" mapstructure:"wallet"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host,omitempty" json:"host" mapstructure:"host"`
+	Port           string `yaml:"port,omitempty" json:"port" mapstructure:"port"`
+	ServiceName    string `yaml:"service_name,omitempty" json:"service_name" mapstructure:"service_name"`
+	SID            string `yaml:"sid,omitempty" json:"sid" mapstructure:"sid"`
+	Role           string `yaml:"role,omitempty" json:"role" mapstructure:"role"`
+	SSL            bool   `yaml:"ssl,omitempty" json:"ssl" mapstructure:"ssl"`
+	SSLVerify      bool   `yaml:"ssl_verify,omitempty" json:"ssl_verify" mapstructure:"ssl_verify"`
+	PrefetchRows   int    `yaml:"prefetch_rows,omitempty" json:"prefetch_rows" mapstructure:"prefetch_rows"`
+	TraceFile      string `yaml:"trace_file,omitempty" json:"trace_file" mapstructure:"trace_file"`
+	Wallet         string `yaml:"wallet,omitempty" json:"wallet" mapstructure:"wallet"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c OracleConnection) GetName() string {
 	return c.Name
 }
 
+func (c OracleConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type PhantombusterConnection struct {
 	Name   string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
 	APIKey string `yaml:"api_key,omitempty" json:"api_key" mapstructure:"api_key"`
@@ -1387,20 +1500,59 @@ func (c QuickSightConnection) GetName() string {
 	return c.Name
 }
 
-type TrinoConnection struct {
-	Name     string `yaml:"name" json:"name" mapstructure:"name"`
-	Host     string `yaml:"host" json:"host" mapstructure:"host"`
-	Port     int    `yaml:"port" json:"port" mapstructure:"port"`
-	Username string `yaml:"username" json:"username" mapstructure:"username"`
+type LookerConnection struct {
+	Name         string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	BaseURL      string `yaml:"base_url,omitempty" json:"base_url" mapstructure:"base_url"`
+	ClientID     string `yaml:"client_id,omitempty" json:"client_id" mapstructure:"client_id"`
+	ClientSecret string `yaml:"client_secret,omitempty" json:"client_secret" mapstructure:"client_secret"`
+}
+
+func (c LookerConnection) GetName() string {
+	return c.Name
+}
+
+type PowerBIConnection struct {
+	Name         string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	TenantID     string `yaml:"tenant_id,omitempty" json:"tenant_id" mapstructure:"tenant_id"`
+	ClientID     string `yaml:"client_id,omitempty" json:"client_id" mapstructure:"client_id"`
+	ClientSecret string `yaml:"client_secret,omitempty" json:"client_secret" mapstructure:"client_secret"`
+}
+
+func (c PowerBIConnection) GetName() string {
+	return c.Name
+}
+
+type MetabaseConnection struct {
+	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	BaseURL  string `yaml:"base_url,omitempty" json:"base_url" mapstructure:"base_url"`
+	APIKey   string `yaml:"api_key,omitempty" json:"api_key,omitempty" mapstructure:"api_key"`
+	Username string `yaml:"username,omitempty" json:"username,omitempty" mapstructure:"username"`
 	Password string `yaml:"password,omitempty" json:"password,omitempty" mapstructure:"password"`
-	Catalog  string `yaml:"catalog" json:"catalog" mapstructure:"catalog"`
-	Schema   string `yaml:"schema" json:"schema" mapstructure:"schema"`
+}
+
+func (c MetabaseConnection) GetName() string {
+	return c.Name
+}
+
+type TrinoConnection struct {
+	Name           string `yaml:"name" json:"name" mapstructure:"name"`
+	Host           string `yaml:"host" json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port" json:"port" mapstructure:"port"`
+	Username       string `yaml:"username" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password,omitempty" mapstructure:"password"`
+	Catalog        string `yaml:"catalog" json:"catalog" mapstructure:"catalog"`
+	Schema         string `yaml:"schema" json:"schema" mapstructure:"schema"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c TrinoConnection) GetName() string {
 	return c.Name
 }
 
+func (c TrinoConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 func (c TableauConnection) GetName() string {
 	return c.Name
 }
@@ -1514,19 +1666,24 @@ func (c CustomerIoConnection) GetName() string {
 }
 
 type VerticaConnection struct {
-	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
-	Username string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
-	Password string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
-	Host     string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
-	Port     int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=5433"`
-	Database string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
-	Schema   string `yaml:"schema,omitempty"   json:"schema,omitempty" mapstructure:"schema"`
+	Name           string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	Username       string `yaml:"username,omitempty" json:"username" mapstructure:"username"`
+	Password       string `yaml:"password,omitempty" json:"password" mapstructure:"password"`
+	Host           string `yaml:"host,omitempty"     json:"host" mapstructure:"host"`
+	Port           int    `yaml:"port,omitempty"     json:"port" mapstructure:"port" jsonschema:"default=5433"`
+	Database       string `yaml:"database,omitempty" json:"database" mapstructure:"database"`
+	Schema         string `yaml:"schema,omitempty"   json:"schema,omitempty" mapstructure:"schema"`
+	MaxConcurrency int    `yaml:"max_concurrency,omitempty" json:"max_concurrency,omitempty" mapstructure:"max_concurrency"`
 }
 
 func (c VerticaConnection) GetName() string {
 	return c.Name
 }
 
+func (c VerticaConnection) GetMaxConcurrency() int {
+	return c.MaxConcurrency
+}
+
 type SurveyMonkeyConnection struct {
 	Name        string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
 	AccessToken string `yaml:"access_token,omitempty" json:"access_token" mapstructure:"access_token"`
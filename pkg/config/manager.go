@@ -68,6 +68,9 @@ type Connections struct {
 	SnapchatAds         []SnapchatAdsConnection         `yaml:"snapchatads,omitempty" json:"snapchatads,omitempty" mapstructure:"snapchatads"`
 	S3                  []S3Connection                  `yaml:"s3,omitempty" json:"s3,omitempty" mapstructure:"s3"`
 	Slack               []SlackConnection               `yaml:"slack,omitempty" json:"slack,omitempty" mapstructure:"slack"`
+	MSTeams             []MSTeamsConnection             `yaml:"ms_teams,omitempty" json:"ms_teams,omitempty" mapstructure:"ms_teams"`
+	Discord             []DiscordConnection             `yaml:"discord,omitempty" json:"discord,omitempty" mapstructure:"discord"`
+	Webhook             []WebhookConnection             `yaml:"webhook,omitempty" json:"webhook,omitempty" mapstructure:"webhook"`
 	Socrata             []SocrataConnection             `yaml:"socrata,omitempty" json:"socrata,omitempty" mapstructure:"socrata"`
 	Asana               []AsanaConnection               `yaml:"asana,omitempty" json:"asana,omitempty" mapstructure:"asana"`
 	DynamoDB            []DynamoDBConnection            `yaml:"dynamodb,omitempty" json:"dynamodb,omitempty" mapstructure:"dynamodb"`
@@ -113,6 +116,9 @@ type Connections struct {
 	InfluxDB            []InfluxDBConnection            `yaml:"influxdb,omitempty" json:"influxdb,omitempty" mapstructure:"influxdb"`
 	Tableau             []TableauConnection             `yaml:"tableau,omitempty" json:"tableau,omitempty" mapstructure:"tableau"`
 	QuickSight          []QuickSightConnection          `yaml:"quicksight,omitempty" json:"quicksight,omitempty" mapstructure:"quicksight"`
+	Looker              []LookerConnection              `yaml:"looker,omitempty" json:"looker,omitempty" mapstructure:"looker"`
+	PowerBI             []PowerBIConnection             `yaml:"powerbi,omitempty" json:"powerbi,omitempty" mapstructure:"powerbi"`
+	Metabase            []MetabaseConnection            `yaml:"metabase,omitempty" json:"metabase,omitempty" mapstructure:"metabase"`
 	Trino               []TrinoConnection               `yaml:"trino,omitempty" json:"trino,omitempty" mapstructure:"trino"`
 	Fluxx               []FluxxConnection               `yaml:"fluxx,omitempty" json:"fluxx,omitempty" mapstructure:"fluxx"`
 	Freshdesk           []FreshdeskConnection           `yaml:"freshdesk,omitempty" json:"freshdesk,omitempty" mapstructure:"freshdesk"`
@@ -173,6 +179,44 @@ func (c *Connections) Exists(name string) bool {
 	return ok
 }
 
+// Get returns a pointer to the connection with the given name, or nil if there is no such connection.
+func (c *Connections) Get(name string) any {
+	if c == nil {
+		return nil
+	}
+
+	if c.byKey == nil {
+		c.buildConnectionKeyMap()
+	}
+
+	return c.byKey[name]
+}
+
+// ConcurrencyLimits returns the `max_concurrency` values keyed by connection name, connections without a limit are omitted.
+func (c *Connections) ConcurrencyLimits() map[string]int {
+	limits := make(map[string]int)
+	if c == nil {
+		return limits
+	}
+
+	if c.byKey == nil {
+		c.buildConnectionKeyMap()
+	}
+
+	for name, conn := range c.byKey {
+		limited, ok := conn.(ConcurrencyLimited)
+		if !ok {
+			continue
+		}
+
+		if limit := limited.GetMaxConcurrency(); limit > 0 {
+			limits[name] = limit
+		}
+	}
+
+	return limits
+}
+
 func (c *Connections) buildConnectionKeyMap() {
 	c.byKey = make(map[string]any)
 	c.typeNameMap = make(map[string]string)
@@ -740,6 +784,27 @@ func (c *Config) AddConnection(environmentName, name, connType string, creds map
 		}
 		conn.Name = name
 		env.Connections.Slack = append(env.Connections.Slack, conn)
+	case "ms_teams":
+		var conn MSTeamsConnection
+		if err := mapstructure.Decode(creds, &conn); err != nil {
+			return fmt.Errorf("failed to decode credentials: %w", err)
+		}
+		conn.Name = name
+		env.Connections.MSTeams = append(env.Connections.MSTeams, conn)
+	case "discord":
+		var conn DiscordConnection
+		if err := mapstructure.Decode(creds, &conn); err != nil {
+			return fmt.Errorf("failed to decode credentials: %w", err)
+		}
+		conn.Name = name
+		env.Connections.Discord = append(env.Connections.Discord, conn)
+	case "webhook":
+		var conn WebhookConnection
+		if err := mapstructure.Decode(creds, &conn); err != nil {
+			return fmt.Errorf("failed to decode credentials: %w", err)
+		}
+		conn.Name = name
+		env.Connections.Webhook = append(env.Connections.Webhook, conn)
 	case "duckdb":
 		var conn DuckDBConnection
 		if err := mapstructure.Decode(creds, &conn); err != nil {
@@ -1106,6 +1171,27 @@ func (c *Config) AddConnection(environmentName, name, connType string, creds map
 		}
 		conn.Name = name
 		env.Connections.QuickSight = append(env.Connections.QuickSight, conn)
+	case "looker":
+		var conn LookerConnection
+		if err := mapstructure.Decode(creds, &conn); err != nil {
+			return fmt.Errorf("failed to decode credentials: %w", err)
+		}
+		conn.Name = name
+		env.Connections.Looker = append(env.Connections.Looker, conn)
+	case "powerbi":
+		var conn PowerBIConnection
+		if err := mapstructure.Decode(creds, &conn); err != nil {
+			return fmt.Errorf("failed to decode credentials: %w", err)
+		}
+		conn.Name = name
+		env.Connections.PowerBI = append(env.Connections.PowerBI, conn)
+	case "metabase":
+		var conn MetabaseConnection
+		if err := mapstructure.Decode(creds, &conn); err != nil {
+			return fmt.Errorf("failed to decode credentials: %w", err)
+		}
+		conn.Name = name
+		env.Connections.Metabase = append(env.Connections.Metabase, conn)
 	case "fluxx":
 		var conn FluxxConnection
 		if err := mapstructure.Decode(creds, &conn); err != nil {
@@ -1286,6 +1372,12 @@ func (c *Config) DeleteConnection(environmentName, connectionName string) error
 		env.Connections.S3 = removeConnection(env.Connections.S3, connectionName)
 	case "slack":
 		env.Connections.Slack = removeConnection(env.Connections.Slack, connectionName)
+	case "ms_teams":
+		env.Connections.MSTeams = removeConnection(env.Connections.MSTeams, connectionName)
+	case "discord":
+		env.Connections.Discord = removeConnection(env.Connections.Discord, connectionName)
+	case "webhook":
+		env.Connections.Webhook = removeConnection(env.Connections.Webhook, connectionName)
 	case "zendesk":
 		env.Connections.Zendesk = removeConnection(env.Connections.Zendesk, connectionName)
 	case "duckdb":
@@ -1390,6 +1482,12 @@ func (c *Config) DeleteConnection(environmentName, connectionName string) error
 		env.Connections.Tableau = removeConnection(env.Connections.Tableau, connectionName)
 	case "quicksight":
 		env.Connections.QuickSight = removeConnection(env.Connections.QuickSight, connectionName)
+	case "looker":
+		env.Connections.Looker = removeConnection(env.Connections.Looker, connectionName)
+	case "powerbi":
+		env.Connections.PowerBI = removeConnection(env.Connections.PowerBI, connectionName)
+	case "metabase":
+		env.Connections.Metabase = removeConnection(env.Connections.Metabase, connectionName)
 	case "fluxx":
 		env.Connections.Fluxx = removeConnection(env.Connections.Fluxx, connectionName)
 	case "fundraiseup":
@@ -1432,6 +1530,11 @@ type Named interface {
 	GetName() string
 }
 
+// ConcurrencyLimited is implemented by the connections that accept a `max_concurrency` setting.
+type ConcurrencyLimited interface {
+	GetMaxConcurrency() int
+}
+
 func removeConnection[T interface{ GetName() string }](connections []T, name string) []T {
 	for i, conn := range connections {
 		if conn.GetName() == name {
@@ -1512,6 +1615,9 @@ func (c *Connections) MergeFrom(source *Connections) error {
 	mergeConnectionList(&c.SnapchatAds, source.SnapchatAds)
 	mergeConnectionList(&c.S3, source.S3)
 	mergeConnectionList(&c.Slack, source.Slack)
+	mergeConnectionList(&c.MSTeams, source.MSTeams)
+	mergeConnectionList(&c.Discord, source.Discord)
+	mergeConnectionList(&c.Webhook, source.Webhook)
 	mergeConnectionList(&c.Socrata, source.Socrata)
 	mergeConnectionList(&c.Asana, source.Asana)
 	mergeConnectionList(&c.DynamoDB, source.DynamoDB)
@@ -1557,6 +1663,9 @@ func (c *Connections) MergeFrom(source *Connections) error {
 	mergeConnectionList(&c.InfluxDB, source.InfluxDB)
 	mergeConnectionList(&c.Tableau, source.Tableau)
 	mergeConnectionList(&c.QuickSight, source.QuickSight)
+	mergeConnectionList(&c.Looker, source.Looker)
+	mergeConnectionList(&c.PowerBI, source.PowerBI)
+	mergeConnectionList(&c.Metabase, source.Metabase)
 	mergeConnectionList(&c.Trino, source.Trino)
 	mergeConnectionList(&c.Fluxx, source.Fluxx)
 	mergeConnectionList(&c.Freshdesk, source.Freshdesk)
@@ -1151,6 +1151,43 @@ func TestConfig_SelectEnvironment(t *testing.T) {
 	assert.Equal(t, "default", conf.SelectedEnvironmentName)
 }
 
+func TestConnections_ConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	configContent := `default_environment: default
+environments:
+  default:
+    connections:
+      duckdb:
+        - name: duckdb-default
+          path: duckdb.db
+          max_concurrency: 1
+      google_cloud_platform:
+        - name: gcp-default
+          project_id: my-project
+          max_concurrency: 4
+      postgres:
+        - name: pg-default
+          host: localhost
+      slack:
+        - name: slack-default
+          api_key: key`
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/.bruin.yml", []byte(configContent), 0o644))
+
+	conf, err := LoadFromFileOrEnv(fs, "/.bruin.yml")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int{
+		"duckdb-default": 1,
+		"gcp-default":    4,
+	}, conf.SelectedEnvironment.Connections.ConcurrencyLimits())
+
+	var nilConnections *Connections
+	assert.Empty(t, nilConnections.ConcurrencyLimits())
+}
+
 func TestConfig_AddConnection(t *testing.T) {
 	t.Parallel()
 
@@ -2059,6 +2096,9 @@ func TestConnections_MergeFrom(t *testing.T) {
 				InfluxDB:            []InfluxDBConnection{{Name: "influxdb1"}},
 				Tableau:             []TableauConnection{{Name: "tableau1"}},
 				QuickSight:          []QuickSightConnection{{Name: "quicksight1"}},
+				Looker:              []LookerConnection{{Name: "looker1"}},
+				PowerBI:             []PowerBIConnection{{Name: "powerbi1"}},
+				Metabase:            []MetabaseConnection{{Name: "metabase1"}},
 				Trino:               []TrinoConnection{{Name: "trino1"}},
 				Fluxx:               []FluxxConnection{{Name: "fluxx1"}},
 				Freshdesk:           []FreshdeskConnection{{Name: "freshdesk1"}},
@@ -2166,6 +2206,9 @@ func TestConnections_MergeFrom(t *testing.T) {
 				InfluxDB:            []InfluxDBConnection{{Name: "influxdb1"}},
 				Tableau:             []TableauConnection{{Name: "tableau1"}},
 				QuickSight:          []QuickSightConnection{{Name: "quicksight1"}},
+				Looker:              []LookerConnection{{Name: "looker1"}},
+				PowerBI:             []PowerBIConnection{{Name: "powerbi1"}},
+				Metabase:            []MetabaseConnection{{Name: "metabase1"}},
 				Trino:               []TrinoConnection{{Name: "trino1"}},
 				Fluxx:               []FluxxConnection{{Name: "fluxx1"}},
 				Freshdesk:           []FreshdeskConnection{{Name: "freshdesk1"}},
@@ -2553,3 +2596,45 @@ func TestSnowflakeConnection_MarshalYAML_SingleLinePrivateKey(t *testing.T) {
 		assert.NotContains(t, contentLine, " ", "content line should not contain spaces")
 	}
 }
+
+func TestConnection_MarshalYAML_MaxConcurrencyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("athena", func(t *testing.T) {
+		t.Parallel()
+
+		for _, conn := range []AthenaConnection{
+			{Name: "athena-keys", AccessKey: "key", SecretKey: "secret", QueryResultsPath: "s3://results", MaxConcurrency: 3},
+			{Name: "athena-profile", Profile: "default", QueryResultsPath: "s3://results", MaxConcurrency: 3},
+		} {
+			yamlData, err := yaml.Marshal(&conn)
+			require.NoError(t, err)
+			assert.Contains(t, string(yamlData), "max_concurrency: 3")
+
+			var decoded AthenaConnection
+			require.NoError(t, yaml.Unmarshal(yamlData, &decoded))
+			assert.Equal(t, 3, decoded.MaxConcurrency)
+		}
+	})
+
+	t.Run("snowflake", func(t *testing.T) {
+		t.Parallel()
+
+		conn := SnowflakeConnection{Name: "snowflake", Account: "account", Username: "user", Password: "pass", MaxConcurrency: 5}
+		yamlData, err := yaml.Marshal(&conn)
+		require.NoError(t, err)
+		assert.Contains(t, string(yamlData), "max_concurrency: 5")
+
+		var decoded SnowflakeConnection
+		require.NoError(t, yaml.Unmarshal(yamlData, &decoded))
+		assert.Equal(t, 5, decoded.MaxConcurrency)
+	})
+
+	t.Run("unset limit is omitted", func(t *testing.T) {
+		t.Parallel()
+
+		yamlData, err := yaml.Marshal(&SnowflakeConnection{Name: "snowflake", Account: "account"})
+		require.NoError(t, err)
+		assert.NotContains(t, string(yamlData), "max_concurrency")
+	})
+}
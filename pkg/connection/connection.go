@@ -68,7 +68,9 @@ import (
 	"github.com/bruin-data/bruin/pkg/klaviyo"
 	"github.com/bruin-data/bruin/pkg/linear"
 	"github.com/bruin-data/bruin/pkg/linkedinads"
+	"github.com/bruin-data/bruin/pkg/looker"
 	"github.com/bruin-data/bruin/pkg/mailchimp"
+	"github.com/bruin-data/bruin/pkg/metabase"
 	"github.com/bruin-data/bruin/pkg/mixpanel"
 	"github.com/bruin-data/bruin/pkg/monday"
 	"github.com/bruin-data/bruin/pkg/mongo"
@@ -84,6 +86,7 @@ import (
 	"github.com/bruin-data/bruin/pkg/plusvibeai"
 	"github.com/bruin-data/bruin/pkg/postgres"
 	"github.com/bruin-data/bruin/pkg/posthog"
+	"github.com/bruin-data/bruin/pkg/powerbi"
 	"github.com/bruin-data/bruin/pkg/primer"
 	"github.com/bruin-data/bruin/pkg/quickbooks"
 	"github.com/bruin-data/bruin/pkg/quicksight"
@@ -215,6 +218,9 @@ type Manager struct {
 	InfluxDB             map[string]*influxdb.Client
 	Tableau              map[string]*tableau.Client
 	QuickSight           map[string]*quicksight.Client
+	Looker               map[string]*looker.Client
+	PowerBI              map[string]*powerbi.Client
+	Metabase             map[string]*metabase.Client
 	Trino                map[string]*trino.Client
 	Dune                 map[string]*dune.Client
 	Vertica              map[string]*vertica.DB
@@ -2821,6 +2827,85 @@ func (m *Manager) AddQuickSightConnectionFromConfig(connection *config.QuickSigh
 	return nil
 }
 
+func (m *Manager) AddLookerConnectionFromConfig(connection *config.LookerConnection) error {
+	m.mutex.Lock()
+	if m.Looker == nil {
+		m.Looker = make(map[string]*looker.Client)
+	}
+	m.mutex.Unlock()
+
+	client, err := looker.NewClient(looker.Config{
+		Name:         connection.Name,
+		BaseURL:      connection.BaseURL,
+		ClientID:     connection.ClientID,
+		ClientSecret: connection.ClientSecret,
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.Looker[connection.Name] = client
+	m.availableConnections[connection.Name] = client
+	m.AllConnectionDetails[connection.Name] = connection
+
+	return nil
+}
+
+func (m *Manager) AddPowerBIConnectionFromConfig(connection *config.PowerBIConnection) error {
+	m.mutex.Lock()
+	if m.PowerBI == nil {
+		m.PowerBI = make(map[string]*powerbi.Client)
+	}
+	m.mutex.Unlock()
+
+	client, err := powerbi.NewClient(powerbi.Config{
+		Name:         connection.Name,
+		TenantID:     connection.TenantID,
+		ClientID:     connection.ClientID,
+		ClientSecret: connection.ClientSecret,
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.PowerBI[connection.Name] = client
+	m.availableConnections[connection.Name] = client
+	m.AllConnectionDetails[connection.Name] = connection
+
+	return nil
+}
+
+func (m *Manager) AddMetabaseConnectionFromConfig(connection *config.MetabaseConnection) error {
+	m.mutex.Lock()
+	if m.Metabase == nil {
+		m.Metabase = make(map[string]*metabase.Client)
+	}
+	m.mutex.Unlock()
+
+	client, err := metabase.NewClient(metabase.Config{
+		Name:     connection.Name,
+		BaseURL:  connection.BaseURL,
+		APIKey:   connection.APIKey,
+		Username: connection.Username,
+		Password: connection.Password,
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.Metabase[connection.Name] = client
+	m.availableConnections[connection.Name] = client
+	m.AllConnectionDetails[connection.Name] = connection
+
+	return nil
+}
+
 func (m *Manager) AddTrinoConnectionFromConfig(connection *config.TrinoConnection) error {
 	m.mutex.Lock()
 	if m.Trino == nil {
@@ -3044,6 +3129,9 @@ func NewManagerFromConfigWithContext(ctx context.Context, cm *config.Config) (co
 	processConnections(cm.SelectedEnvironment.Connections.InfluxDB, connectionManager.AddInfluxDBConnectionFromConfig, &wg, &errList, &mu)
 	processConnections(cm.SelectedEnvironment.Connections.Tableau, connectionManager.AddTableauConnectionFromConfig, &wg, &errList, &mu)
 	processConnections(cm.SelectedEnvironment.Connections.QuickSight, connectionManager.AddQuickSightConnectionFromConfig, &wg, &errList, &mu)
+	processConnections(cm.SelectedEnvironment.Connections.Looker, connectionManager.AddLookerConnectionFromConfig, &wg, &errList, &mu)
+	processConnections(cm.SelectedEnvironment.Connections.PowerBI, connectionManager.AddPowerBIConnectionFromConfig, &wg, &errList, &mu)
+	processConnections(cm.SelectedEnvironment.Connections.Metabase, connectionManager.AddMetabaseConnectionFromConfig, &wg, &errList, &mu)
 	processConnections(cm.SelectedEnvironment.Connections.Generic, connectionManager.AddGenericConnectionFromConfig, &wg, &errList, &mu)
 	processConnections(cm.SelectedEnvironment.Connections.Trino, connectionManager.AddTrinoConnectionFromConfig, &wg, &errList, &mu)
 	processConnections(cm.SelectedEnvironment.Connections.Vertica, connectionManager.AddVerticaConnectionFromConfig, &wg, &errList, &mu)
@@ -0,0 +1,601 @@
+package diff
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/sourcegraph/conc"
+)
+
+const (
+	defaultRowDiffBucketCount   = 32
+	defaultRowDiffMaxBucketRows = 1000
+	defaultRowDiffSampleSize    = 10
+
+	// the hashes are built from the first 8 hex characters of an MD5 digest, which keeps their sums far away from
+	// integer overflows on every dialect while still giving enough buckets to narrow the differences down.
+	rowHashSpace = uint64(1) << 32
+
+	rowNullValue    = "NULL"
+	rowKeySeparator = "\x1f"
+
+	// the values are prefixed before they are hashed so that a NULL never hashes the same as any string, including
+	// the string 'NULL'
+	rowHashNullMarker  = "N"
+	rowHashValueMarker = "V"
+
+	// the numbers are hashed with a fixed scale, so that integers, decimals and floats holding the same value hash the
+	// same on every platform
+	rowHashNumericScale = 9
+)
+
+// RowQuerier is implemented by connections that can run a query and return the resulting rows.
+type RowQuerier interface {
+	Select(ctx context.Context, q *query.Query) ([][]interface{}, error)
+}
+
+// RowDiffTable describes one side of a row-level comparison.
+type RowDiffTable struct {
+	Name    string
+	Querier RowQuerier
+	Dialect DatabaseDialect
+}
+
+// RowDiffColumn pairs a column of the first table with its counterpart in the second table, the names may differ
+// in casing across platforms.
+type RowDiffColumn struct {
+	Table1Name string
+	Table2Name string
+	Type       CommonDataType
+}
+
+type RowDiffOptions struct {
+	KeyColumns    []RowDiffColumn
+	Columns       []RowDiffColumn
+	BucketCount   int
+	MaxBucketRows int
+	SampleSize    int
+}
+
+// RowSample is a row that only exists in one of the tables.
+type RowSample struct {
+	Key    []string `json:"key"`
+	Values []string `json:"values"`
+}
+
+type ColumnValueDifference struct {
+	Column      string `json:"column"`
+	SourceValue string `json:"source"`
+	TargetValue string `json:"target"`
+}
+
+// ChangedRow is a row that exists in both tables with the same key but with different values.
+type ChangedRow struct {
+	Key         []string                `json:"key"`
+	Differences []ColumnValueDifference `json:"differences"`
+}
+
+// RowDiffResult holds the outcome of a row-level comparison. Added rows only exist in the second table, removed
+// rows only exist in the first one.
+type RowDiffResult struct {
+	KeyColumns   []string     `json:"keyColumns"`
+	Columns      []string     `json:"columns"`
+	AddedCount   int64        `json:"addedCount"`
+	RemovedCount int64        `json:"removedCount"`
+	ChangedCount int64        `json:"changedCount"`
+	Added        []RowSample  `json:"added"`
+	Removed      []RowSample  `json:"removed"`
+	Changed      []ChangedRow `json:"changed"`
+}
+
+func (r *RowDiffResult) HasDifferences() bool {
+	return r.AddedCount > 0 || r.RemovedCount > 0 || r.ChangedCount > 0
+}
+
+// rowHashDialect holds the dialect-specific SQL fragments used to hash rows. The hashes must be identical across
+// dialects for the same values so that tables living on different platforms can be compared, which is why the values
+// are first formatted the same way on every platform by their type, rather than with the default casts to strings.
+type rowHashDialect struct {
+	castToString    func(expr string) string
+	formatNumeric   func(expr string) string
+	formatTimestamp func(expr string) string
+	hashToInt       func(expr string) string
+	mod             func(expr string, modulus uint64) string
+}
+
+// formatValue returns the canonical string of the value of a column, naive timestamps are read in the time zone of
+// the session before they are converted to UTC.
+func (d rowHashDialect) formatValue(expr string, columnType CommonDataType) string {
+	switch columnType {
+	case CommonTypeNumeric:
+		return d.formatNumeric(expr)
+	case CommonTypeDateTime:
+		return d.formatTimestamp(expr)
+	case CommonTypeBoolean:
+		return fmt.Sprintf("CASE WHEN %s THEN 'true' ELSE 'false' END", expr)
+	default:
+		return d.castToString(expr)
+	}
+}
+
+func sqlMod(expr string, modulus uint64) string {
+	return fmt.Sprintf("MOD(%s, %d)", expr, modulus)
+}
+
+var rowHashDialects = map[DatabaseDialect]rowHashDialect{
+	DialectPostgreSQL: {
+		castToString: func(expr string) string { return fmt.Sprintf("CAST(%s AS TEXT)", expr) },
+		formatNumeric: func(expr string) string {
+			return fmt.Sprintf("CAST(CAST(%s AS NUMERIC(38, %d)) AS TEXT)", expr, rowHashNumericScale)
+		},
+		formatTimestamp: func(expr string) string {
+			return fmt.Sprintf(`TO_CHAR(CAST(%s AS TIMESTAMPTZ) AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US')`, expr)
+		},
+		hashToInt: func(expr string) string {
+			return fmt.Sprintf("('x' || LPAD(SUBSTR(MD5(%s), 1, 8), 16, '0'))::BIT(64)::BIGINT", expr)
+		},
+		mod: sqlMod,
+	},
+	DialectSnowflake: {
+		castToString: func(expr string) string { return fmt.Sprintf("CAST(%s AS VARCHAR)", expr) },
+		formatNumeric: func(expr string) string {
+			return fmt.Sprintf("TO_VARCHAR(CAST(%s AS NUMBER(38, %d)))", expr, rowHashNumericScale)
+		},
+		formatTimestamp: func(expr string) string {
+			return fmt.Sprintf(`TO_VARCHAR(CONVERT_TIMEZONE('UTC', CAST(%s AS TIMESTAMP_TZ)), 'YYYY-MM-DD"T"HH24:MI:SS.FF6')`, expr)
+		},
+		hashToInt: func(expr string) string {
+			return fmt.Sprintf("TO_NUMBER(SUBSTR(MD5(%s), 1, 8), 'XXXXXXXX')", expr)
+		},
+		mod: sqlMod,
+	},
+	DialectBigQuery: {
+		castToString: func(expr string) string { return fmt.Sprintf("CAST(%s AS STRING)", expr) },
+		formatNumeric: func(expr string) string {
+			return fmt.Sprintf("FORMAT('%%.%df', CAST(%s AS BIGNUMERIC))", rowHashNumericScale, expr)
+		},
+		formatTimestamp: func(expr string) string {
+			return fmt.Sprintf("FORMAT_TIMESTAMP('%%Y-%%m-%%dT%%H:%%M:%%E6S', CAST(%s AS TIMESTAMP), 'UTC')", expr)
+		},
+		hashToInt: func(expr string) string {
+			return fmt.Sprintf("CAST(CONCAT('0x', SUBSTR(TO_HEX(MD5(%s)), 1, 8)) AS INT64)", expr)
+		},
+		mod: sqlMod,
+	},
+	DialectDuckDB: {
+		castToString: func(expr string) string { return fmt.Sprintf("CAST(%s AS VARCHAR)", expr) },
+		formatNumeric: func(expr string) string {
+			return fmt.Sprintf("CAST(CAST(%s AS DECIMAL(38, %d)) AS VARCHAR)", expr, rowHashNumericScale)
+		},
+		formatTimestamp: func(expr string) string {
+			return fmt.Sprintf("STRFTIME(CAST(%s AS TIMESTAMPTZ) AT TIME ZONE 'UTC', '%%Y-%%m-%%dT%%H:%%M:%%S.%%f')", expr)
+		},
+		hashToInt: func(expr string) string {
+			return fmt.Sprintf("CAST('0x' || SUBSTR(MD5(%s), 1, 8) AS BIGINT)", expr)
+		},
+		mod: func(expr string, modulus uint64) string { return fmt.Sprintf("(%s %% %d)", expr, modulus) },
+	},
+}
+
+// DialectForConnection detects the SQL dialect of a connection from its type.
+func DialectForConnection(conn any) DatabaseDialect {
+	return dialectFromConnectionType(fmt.Sprintf("%T", conn))
+}
+
+// rowDiffSide builds the hashing queries for one of the compared tables.
+type rowDiffSide struct {
+	table       RowDiffTable
+	dialect     rowHashDialect
+	keys        []string
+	keyTypes    []CommonDataType
+	columns     []string
+	columnTypes []CommonDataType
+}
+
+func (s *rowDiffSide) concat(columns []string, types []CommonDataType) string {
+	parts := make([]string, 0, len(columns))
+	for i, col := range columns {
+		parts = append(parts, fmt.Sprintf(
+			"CASE WHEN %s IS NULL THEN '%s' ELSE '%s' || %s END",
+			col, rowHashNullMarker, rowHashValueMarker, s.dialect.formatValue(col, types[i]),
+		))
+	}
+
+	return strings.Join(parts, " || '|' || ")
+}
+
+func (s *rowDiffSide) keyHash() string {
+	return s.dialect.hashToInt(s.concat(s.keys, s.keyTypes))
+}
+
+func (s *rowDiffSide) rowHash() string {
+	return s.dialect.hashToInt(s.concat(
+		append(append([]string{}, s.keys...), s.columns...),
+		append(append([]CommonDataType{}, s.keyTypes...), s.columnTypes...),
+	))
+}
+
+func (s *rowDiffSide) segmentFilter(seg rowSegment) string {
+	if seg.modulus <= 1 {
+		return ""
+	}
+
+	return fmt.Sprintf(" WHERE %s = %d", s.dialect.mod(s.keyHash(), seg.modulus), seg.remainder)
+}
+
+func (s *rowDiffSide) bucketQuery(seg rowSegment, childModulus uint64) string {
+	return fmt.Sprintf(
+		"SELECT %s, %s, %s FROM (SELECT %s AS bucket, %s AS row_hash FROM %s%s) hashed GROUP BY bucket",
+		s.dialect.castToString("bucket"),
+		s.dialect.castToString("COUNT(*)"),
+		s.dialect.castToString("SUM(row_hash)"),
+		s.dialect.mod(s.keyHash(), childModulus),
+		s.rowHash(),
+		s.table.Name,
+		s.segmentFilter(seg),
+	)
+}
+
+func (s *rowDiffSide) rowsQuery(seg rowSegment) string {
+	return fmt.Sprintf(
+		"SELECT %s FROM %s%s",
+		strings.Join(append(append([]string{}, s.keys...), s.columns...), ", "),
+		s.table.Name,
+		s.segmentFilter(seg),
+	)
+}
+
+// rowSegment is the set of rows whose key hash modulo `modulus` equals `remainder`.
+type rowSegment struct {
+	modulus   uint64
+	remainder uint64
+}
+
+type bucketStats struct {
+	count    int64
+	checksum string
+}
+
+type rowDiffer struct {
+	side1   *rowDiffSide
+	side2   *rowDiffSide
+	opts    RowDiffOptions
+	columns []RowDiffColumn
+	result  *RowDiffResult
+}
+
+// DiffRows compares two tables row by row using their key columns. Both tables are split into buckets by the
+// hash of their keys, buckets whose row counts or checksums differ are split further until they are small enough to
+// be fetched and compared value by value.
+func DiffRows(ctx context.Context, table1, table2 RowDiffTable, opts RowDiffOptions) (*RowDiffResult, error) {
+	if len(opts.KeyColumns) == 0 {
+		return nil, errors.New("row-level diff requires at least one key column")
+	}
+
+	dialect1, ok := rowHashDialects[table1.Dialect]
+	if !ok {
+		return nil, fmt.Errorf("row-level diff is not supported for the '%s' dialect of table '%s'", table1.Dialect, table1.Name)
+	}
+	dialect2, ok := rowHashDialects[table2.Dialect]
+	if !ok {
+		return nil, fmt.Errorf("row-level diff is not supported for the '%s' dialect of table '%s'", table2.Dialect, table2.Name)
+	}
+
+	if opts.BucketCount < 2 {
+		opts.BucketCount = defaultRowDiffBucketCount
+	}
+	if opts.MaxBucketRows <= 0 {
+		opts.MaxBucketRows = defaultRowDiffMaxBucketRows
+	}
+	if opts.SampleSize <= 0 {
+		opts.SampleSize = defaultRowDiffSampleSize
+	}
+
+	d := &rowDiffer{
+		side1:   &rowDiffSide{table: table1, dialect: dialect1},
+		side2:   &rowDiffSide{table: table2, dialect: dialect2},
+		opts:    opts,
+		columns: append(append([]RowDiffColumn{}, opts.KeyColumns...), opts.Columns...),
+		result: &RowDiffResult{
+			Added:   []RowSample{},
+			Removed: []RowSample{},
+			Changed: []ChangedRow{},
+		},
+	}
+
+	for _, col := range opts.KeyColumns {
+		d.side1.keys = append(d.side1.keys, col.Table1Name)
+		d.side2.keys = append(d.side2.keys, col.Table2Name)
+		d.side1.keyTypes = append(d.side1.keyTypes, col.Type)
+		d.side2.keyTypes = append(d.side2.keyTypes, col.Type)
+		d.result.KeyColumns = append(d.result.KeyColumns, col.Table1Name)
+	}
+	for _, col := range opts.Columns {
+		d.side1.columns = append(d.side1.columns, col.Table1Name)
+		d.side2.columns = append(d.side2.columns, col.Table2Name)
+		d.side1.columnTypes = append(d.side1.columnTypes, col.Type)
+		d.side2.columnTypes = append(d.side2.columnTypes, col.Type)
+		d.result.Columns = append(d.result.Columns, col.Table1Name)
+	}
+
+	if err := d.diffSegment(ctx, rowSegment{modulus: 1, remainder: 0}); err != nil {
+		return nil, err
+	}
+
+	return d.result, nil
+}
+
+func (d *rowDiffer) diffSegment(ctx context.Context, seg rowSegment) error {
+	childModulus := seg.modulus * uint64(d.opts.BucketCount)
+
+	var buckets1, buckets2 map[uint64]bucketStats
+	var err1, err2 error
+	var wg conc.WaitGroup
+	wg.Go(func() {
+		buckets1, err1 = d.fetchBuckets(ctx, d.side1, seg, childModulus)
+	})
+	wg.Go(func() {
+		buckets2, err2 = d.fetchBuckets(ctx, d.side2, seg, childModulus)
+	})
+	wg.Wait()
+
+	if err1 != nil {
+		return fmt.Errorf("failed to hash the rows of table '%s': %w", d.side1.table.Name, err1)
+	}
+	if err2 != nil {
+		return fmt.Errorf("failed to hash the rows of table '%s': %w", d.side2.table.Name, err2)
+	}
+
+	mismatching := make([]uint64, 0)
+	for bucket, stats1 := range buckets1 {
+		if stats2, ok := buckets2[bucket]; !ok || stats1 != stats2 {
+			mismatching = append(mismatching, bucket)
+		}
+	}
+	for bucket := range buckets2 {
+		if _, ok := buckets1[bucket]; !ok {
+			mismatching = append(mismatching, bucket)
+		}
+	}
+	sort.Slice(mismatching, func(i, j int) bool { return mismatching[i] < mismatching[j] })
+
+	for _, bucket := range mismatching {
+		child := rowSegment{modulus: childModulus, remainder: bucket}
+		rowCount := max(buckets1[bucket].count, buckets2[bucket].count)
+
+		if rowCount <= int64(d.opts.MaxBucketRows) || childModulus*uint64(d.opts.BucketCount) > rowHashSpace {
+			if err := d.diffRowsInSegment(ctx, child); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := d.diffSegment(ctx, child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *rowDiffer) fetchBuckets(ctx context.Context, side *rowDiffSide, seg rowSegment, childModulus uint64) (map[uint64]bucketStats, error) {
+	rows, err := side.table.Querier.Select(ctx, &query.Query{Query: side.bucketQuery(seg, childModulus)})
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make(map[uint64]bucketStats, len(rows))
+	for _, row := range rows {
+		if len(row) != 3 {
+			return nil, fmt.Errorf("unexpected number of columns returned from the bucket query: %d", len(row))
+		}
+
+		bucket, err := strconv.ParseUint(rawString(row[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the bucket '%v': %w", row[0], err)
+		}
+		count, err := strconv.ParseInt(rawString(row[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the row count '%v': %w", row[1], err)
+		}
+
+		buckets[bucket] = bucketStats{count: count, checksum: rawString(row[2])}
+	}
+
+	return buckets, nil
+}
+
+func (d *rowDiffer) diffRowsInSegment(ctx context.Context, seg rowSegment) error {
+	var rows1, rows2 map[string][]string
+	var err1, err2 error
+	var wg conc.WaitGroup
+	wg.Go(func() {
+		rows1, err1 = d.fetchRows(ctx, d.side1, seg)
+	})
+	wg.Go(func() {
+		rows2, err2 = d.fetchRows(ctx, d.side2, seg)
+	})
+	wg.Wait()
+
+	if err1 != nil {
+		return fmt.Errorf("failed to fetch the rows of table '%s': %w", d.side1.table.Name, err1)
+	}
+	if err2 != nil {
+		return fmt.Errorf("failed to fetch the rows of table '%s': %w", d.side2.table.Name, err2)
+	}
+
+	d.compareRows(rows1, rows2)
+	return nil
+}
+
+// fetchRows returns the normalized values of the rows in the segment, indexed by their key.
+func (d *rowDiffer) fetchRows(ctx context.Context, side *rowDiffSide, seg rowSegment) (map[string][]string, error) {
+	rows, err := side.table.Querier.Select(ctx, &query.Query{Query: side.rowsQuery(seg)})
+	if err != nil {
+		return nil, err
+	}
+
+	keyCount := len(side.keys)
+	result := make(map[string][]string, len(rows))
+	for _, row := range rows {
+		if len(row) != len(d.columns) {
+			return nil, fmt.Errorf("unexpected number of columns returned: expected %d, got %d", len(d.columns), len(row))
+		}
+
+		values := make([]string, len(row))
+		for i, v := range row {
+			values[i] = NormalizeRowValue(v, d.columns[i].Type)
+		}
+
+		key := strings.Join(values[:keyCount], rowKeySeparator)
+		if _, exists := result[key]; exists {
+			return nil, fmt.Errorf("the key columns are not unique, key (%s) appears more than once", strings.Join(values[:keyCount], ", "))
+		}
+		result[key] = values
+	}
+
+	return result, nil
+}
+
+func (d *rowDiffer) compareRows(rows1, rows2 map[string][]string) {
+	keyCount := len(d.opts.KeyColumns)
+
+	for _, key := range sortedKeys(rows1) {
+		values1 := rows1[key]
+		values2, ok := rows2[key]
+		if !ok {
+			d.result.RemovedCount++
+			if len(d.result.Removed) < d.opts.SampleSize {
+				d.result.Removed = append(d.result.Removed, RowSample{Key: values1[:keyCount], Values: values1[keyCount:]})
+			}
+			continue
+		}
+
+		var differences []ColumnValueDifference
+		for i := keyCount; i < len(values1); i++ {
+			if values1[i] != values2[i] {
+				differences = append(differences, ColumnValueDifference{
+					Column:      d.columns[i].Table1Name,
+					SourceValue: values1[i],
+					TargetValue: values2[i],
+				})
+			}
+		}
+		if len(differences) == 0 {
+			continue
+		}
+
+		d.result.ChangedCount++
+		if len(d.result.Changed) < d.opts.SampleSize {
+			d.result.Changed = append(d.result.Changed, ChangedRow{Key: values1[:keyCount], Differences: differences})
+		}
+	}
+
+	for _, key := range sortedKeys(rows2) {
+		if _, ok := rows1[key]; ok {
+			continue
+		}
+
+		values2 := rows2[key]
+		d.result.AddedCount++
+		if len(d.result.Added) < d.opts.SampleSize {
+			d.result.Added = append(d.result.Added, RowSample{Key: values2[:keyCount], Values: values2[keyCount:]})
+		}
+	}
+}
+
+func sortedKeys(rows map[string][]string) []string {
+	keys := make([]string, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// NormalizeRowValue turns a value returned by any of the database drivers into a string that can be compared across
+// platforms, e.g. the same number may be returned as an integer by one driver and as a decimal string by another.
+func NormalizeRowValue(value any, columnType CommonDataType) string {
+	switch v := value.(type) {
+	case nil:
+		return rowNullValue
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case *time.Time:
+		if v == nil {
+			return rowNullValue
+		}
+		return v.UTC().Format(time.RFC3339Nano)
+	case *big.Rat:
+		if v == nil {
+			return rowNullValue
+		}
+		return normalizeNumber(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case []byte:
+		return normalizeString(string(v), columnType)
+	case string:
+		return normalizeString(v, columnType)
+	case driver.Valuer:
+		inner, err := v.Value()
+		if err == nil {
+			return NormalizeRowValue(inner, columnType)
+		}
+	}
+
+	return fmt.Sprint(value)
+}
+
+func normalizeString(value string, columnType CommonDataType) string {
+	if columnType == CommonTypeNumeric {
+		if r, ok := new(big.Rat).SetString(strings.TrimSpace(value)); ok {
+			return normalizeNumber(r)
+		}
+	}
+
+	if columnType == CommonTypeBoolean {
+		if b, err := strconv.ParseBool(strings.TrimSpace(value)); err == nil {
+			return strconv.FormatBool(b)
+		}
+	}
+
+	return value
+}
+
+func normalizeNumber(r *big.Rat) string {
+	if r.IsInt() {
+		return r.RatString()
+	}
+
+	f, _ := r.Float64()
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func rawString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+
+	return fmt.Sprint(value)
+}
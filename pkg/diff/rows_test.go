@@ -0,0 +1,316 @@
+package diff
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	bucketModulusRegex = regexp.MustCompile(`[,%] (\d+)\) AS bucket`)
+	segmentFilterRegex = regexp.MustCompile(`WHERE .*[,%] (\d+)\) = (\d+)`)
+)
+
+// inMemoryQuerier answers the bucket and row queries of the row differ from a set of rows kept in memory, the
+// first column of every row is the key.
+type inMemoryQuerier struct {
+	rows    [][]interface{}
+	queries int
+}
+
+func fakeHash(values []interface{}) uint64 {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, fmt.Sprint(v))
+	}
+	sum := md5.Sum([]byte(strings.Join(parts, "|"))) //nolint:gosec
+	n, _ := strconv.ParseUint(hex.EncodeToString(sum[:])[:8], 16, 64)
+	return n
+}
+
+func (q *inMemoryQuerier) Select(ctx context.Context, qry *query.Query) ([][]interface{}, error) {
+	q.queries++
+
+	inSegment := func(row []interface{}) bool {
+		match := segmentFilterRegex.FindStringSubmatch(qry.Query)
+		if match == nil {
+			return true
+		}
+		modulus, _ := strconv.ParseUint(match[1], 10, 64)
+		remainder, _ := strconv.ParseUint(match[2], 10, 64)
+		return fakeHash(row[:1])%modulus == remainder
+	}
+
+	if !strings.HasPrefix(qry.Query, "SELECT CAST(bucket") {
+		result := make([][]interface{}, 0)
+		for _, row := range q.rows {
+			if inSegment(row) {
+				result = append(result, row)
+			}
+		}
+		return result, nil
+	}
+
+	childModulus, _ := strconv.ParseUint(bucketModulusRegex.FindStringSubmatch(qry.Query)[1], 10, 64)
+	counts := map[uint64]int64{}
+	sums := map[uint64]uint64{}
+	for _, row := range q.rows {
+		if !inSegment(row) {
+			continue
+		}
+		bucket := fakeHash(row[:1]) % childModulus
+		counts[bucket]++
+		sums[bucket] += fakeHash(row)
+	}
+
+	result := make([][]interface{}, 0, len(counts))
+	for bucket, count := range counts {
+		result = append(result, []interface{}{strconv.FormatUint(bucket, 10), strconv.FormatInt(count, 10), strconv.FormatUint(sums[bucket], 10)})
+	}
+	return result, nil
+}
+
+func TestDiffRows(t *testing.T) {
+	t.Parallel()
+
+	source := &inMemoryQuerier{}
+	target := &inMemoryQuerier{}
+	for i := range 500 {
+		source.rows = append(source.rows, []interface{}{int64(i), fmt.Sprintf("name-%d", i), float64(i) / 2})
+
+		switch {
+		case i == 10 || i == 20:
+			// removed from the target
+		case i == 30:
+			target.rows = append(target.rows, []interface{}{int64(i), "changed", float64(i) / 2})
+		default:
+			target.rows = append(target.rows, []interface{}{int64(i), fmt.Sprintf("name-%d", i), float64(i) / 2})
+		}
+	}
+	target.rows = append(target.rows, []interface{}{int64(1000), "new", float64(1)})
+
+	result, err := DiffRows(
+		context.Background(),
+		RowDiffTable{Name: "source", Querier: source, Dialect: DialectPostgreSQL},
+		RowDiffTable{Name: "target", Querier: target, Dialect: DialectSnowflake},
+		RowDiffOptions{
+			KeyColumns: []RowDiffColumn{{Table1Name: "id", Table2Name: "ID", Type: CommonTypeNumeric}},
+			Columns: []RowDiffColumn{
+				{Table1Name: "name", Table2Name: "NAME", Type: CommonTypeString},
+				{Table1Name: "amount", Table2Name: "AMOUNT", Type: CommonTypeNumeric},
+			},
+			BucketCount:   4,
+			MaxBucketRows: 5,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.True(t, result.HasDifferences())
+	assert.Equal(t, []string{"id"}, result.KeyColumns)
+	assert.Equal(t, []string{"name", "amount"}, result.Columns)
+
+	assert.Equal(t, int64(1), result.AddedCount)
+	assert.Equal(t, []RowSample{{Key: []string{"1000"}, Values: []string{"new", "1"}}}, result.Added)
+
+	assert.Equal(t, int64(2), result.RemovedCount)
+	assert.ElementsMatch(t, []RowSample{
+		{Key: []string{"10"}, Values: []string{"name-10", "5"}},
+		{Key: []string{"20"}, Values: []string{"name-20", "10"}},
+	}, result.Removed)
+
+	assert.Equal(t, int64(1), result.ChangedCount)
+	assert.Equal(t, []ChangedRow{
+		{
+			Key:         []string{"30"},
+			Differences: []ColumnValueDifference{{Column: "name", SourceValue: "name-30", TargetValue: "changed"}},
+		},
+	}, result.Changed)
+
+	// the mismatching buckets must have been narrowed down rather than fetching the whole tables
+	assert.Greater(t, source.queries, 2)
+}
+
+func TestDiffRows_IdenticalTables(t *testing.T) {
+	t.Parallel()
+
+	rows := [][]interface{}{{int64(1), "a"}, {int64(2), "b"}}
+	source := &inMemoryQuerier{rows: rows}
+	target := &inMemoryQuerier{rows: rows}
+
+	result, err := DiffRows(
+		context.Background(),
+		RowDiffTable{Name: "source", Querier: source, Dialect: DialectDuckDB},
+		RowDiffTable{Name: "target", Querier: target, Dialect: DialectDuckDB},
+		RowDiffOptions{
+			KeyColumns: []RowDiffColumn{{Table1Name: "id", Table2Name: "id", Type: CommonTypeNumeric}},
+			Columns:    []RowDiffColumn{{Table1Name: "name", Table2Name: "name", Type: CommonTypeString}},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.False(t, result.HasDifferences())
+	assert.Empty(t, result.Added)
+	assert.Empty(t, result.Removed)
+	assert.Empty(t, result.Changed)
+	// only the top-level buckets are queried when nothing differs
+	assert.Equal(t, 1, source.queries)
+}
+
+func TestDiffRows_Errors(t *testing.T) {
+	t.Parallel()
+
+	keys := []RowDiffColumn{{Table1Name: "id", Table2Name: "id", Type: CommonTypeNumeric}}
+
+	tests := []struct {
+		name    string
+		table1  RowDiffTable
+		table2  RowDiffTable
+		keys    []RowDiffColumn
+		wantErr string
+	}{
+		{
+			name:    "no key columns",
+			table1:  RowDiffTable{Name: "a", Querier: &inMemoryQuerier{}, Dialect: DialectPostgreSQL},
+			table2:  RowDiffTable{Name: "b", Querier: &inMemoryQuerier{}, Dialect: DialectPostgreSQL},
+			wantErr: "row-level diff requires at least one key column",
+		},
+		{
+			name:    "unsupported dialect",
+			table1:  RowDiffTable{Name: "a", Querier: &inMemoryQuerier{}, Dialect: DialectPostgreSQL},
+			table2:  RowDiffTable{Name: "b", Querier: &inMemoryQuerier{}, Dialect: DialectGeneric},
+			keys:    keys,
+			wantErr: "row-level diff is not supported for the 'generic' dialect of table 'b'",
+		},
+		{
+			name:    "duplicate keys",
+			table1:  RowDiffTable{Name: "a", Querier: &inMemoryQuerier{rows: [][]interface{}{{int64(1)}, {int64(1)}}}, Dialect: DialectPostgreSQL},
+			table2:  RowDiffTable{Name: "b", Querier: &inMemoryQuerier{}, Dialect: DialectPostgreSQL},
+			keys:    keys,
+			wantErr: "the key columns are not unique, key (1) appears more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := DiffRows(context.Background(), tt.table1, tt.table2, RowDiffOptions{KeyColumns: tt.keys})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestRowDiffSide_Queries(t *testing.T) {
+	t.Parallel()
+
+	side := &rowDiffSide{
+		table:       RowDiffTable{Name: "public.orders"},
+		dialect:     rowHashDialects[DialectPostgreSQL],
+		keys:        []string{"id"},
+		keyTypes:    []CommonDataType{CommonTypeNumeric},
+		columns:     []string{"status"},
+		columnTypes: []CommonDataType{CommonTypeString},
+	}
+
+	id := "CASE WHEN id IS NULL THEN 'N' ELSE 'V' || CAST(CAST(id AS NUMERIC(38, 9)) AS TEXT) END"
+	status := "CASE WHEN status IS NULL THEN 'N' ELSE 'V' || CAST(status AS TEXT) END"
+	keyHash := "('x' || LPAD(SUBSTR(MD5(" + id + "), 1, 8), 16, '0'))::BIT(64)::BIGINT"
+	rowHash := "('x' || LPAD(SUBSTR(MD5(" + id + " || '|' || " + status + "), 1, 8), 16, '0'))::BIT(64)::BIGINT"
+
+	assert.Equal(t,
+		"SELECT CAST(bucket AS TEXT), CAST(COUNT(*) AS TEXT), CAST(SUM(row_hash) AS TEXT) FROM "+
+			"(SELECT MOD("+keyHash+", 32) AS bucket, "+rowHash+" AS row_hash FROM public.orders) hashed GROUP BY bucket",
+		side.bucketQuery(rowSegment{modulus: 1}, 32),
+	)
+	assert.Equal(t,
+		"SELECT id, status FROM public.orders WHERE MOD("+keyHash+", 32) = 7",
+		side.rowsQuery(rowSegment{modulus: 32, remainder: 7}),
+	)
+}
+
+func TestRowHashDialect_FormatValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dialect    DatabaseDialect
+		columnType CommonDataType
+		want       string
+	}{
+		{DialectPostgreSQL, CommonTypeNumeric, "CAST(CAST(c AS NUMERIC(38, 9)) AS TEXT)"},
+		{DialectSnowflake, CommonTypeNumeric, "TO_VARCHAR(CAST(c AS NUMBER(38, 9)))"},
+		{DialectBigQuery, CommonTypeNumeric, "FORMAT('%.9f', CAST(c AS BIGNUMERIC))"},
+		{DialectDuckDB, CommonTypeNumeric, "CAST(CAST(c AS DECIMAL(38, 9)) AS VARCHAR)"},
+		{DialectPostgreSQL, CommonTypeDateTime, `TO_CHAR(CAST(c AS TIMESTAMPTZ) AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US')`},
+		{DialectSnowflake, CommonTypeDateTime, `TO_VARCHAR(CONVERT_TIMEZONE('UTC', CAST(c AS TIMESTAMP_TZ)), 'YYYY-MM-DD"T"HH24:MI:SS.FF6')`},
+		{DialectBigQuery, CommonTypeDateTime, "FORMAT_TIMESTAMP('%Y-%m-%dT%H:%M:%E6S', CAST(c AS TIMESTAMP), 'UTC')"},
+		{DialectDuckDB, CommonTypeDateTime, "STRFTIME(CAST(c AS TIMESTAMPTZ) AT TIME ZONE 'UTC', '%Y-%m-%dT%H:%M:%S.%f')"},
+		{DialectSnowflake, CommonTypeBoolean, "CASE WHEN c THEN 'true' ELSE 'false' END"},
+		{DialectBigQuery, CommonTypeString, "CAST(c AS STRING)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.dialect)+"/"+string(tt.columnType), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, rowHashDialects[tt.dialect].formatValue("c", tt.columnType))
+		})
+	}
+}
+
+func TestRowDiffSide_NullIsNotTheStringNull(t *testing.T) {
+	t.Parallel()
+
+	side := &rowDiffSide{dialect: rowHashDialects[DialectDuckDB]}
+	concat := side.concat([]string{"name"}, []CommonDataType{CommonTypeString})
+
+	// a NULL hashes as the bare marker while every value, including the string 'NULL', is prefixed
+	assert.Equal(t, "CASE WHEN name IS NULL THEN 'N' ELSE 'V' || CAST(name AS VARCHAR) END", concat)
+	assert.NotContains(t, concat, "COALESCE")
+}
+
+func TestNormalizeRowValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		value      any
+		columnType CommonDataType
+		want       string
+	}{
+		{name: "nil", value: nil, want: "NULL"},
+		{name: "integer", value: int32(42), columnType: CommonTypeNumeric, want: "42"},
+		{name: "float", value: 4.5, columnType: CommonTypeNumeric, want: "4.5"},
+		{name: "decimal string with trailing zeros", value: "4.50", columnType: CommonTypeNumeric, want: "4.5"},
+		{name: "integer decimal string", value: "42.000", columnType: CommonTypeNumeric, want: "42"},
+		{name: "big rat", value: big.NewRat(9, 2), columnType: CommonTypeNumeric, want: "4.5"},
+		{name: "numeric-looking string column is kept as is", value: "042", columnType: CommonTypeString, want: "042"},
+		{name: "boolean", value: true, columnType: CommonTypeBoolean, want: "true"},
+		{name: "boolean string", value: "t", columnType: CommonTypeBoolean, want: "true"},
+		{name: "bytes", value: []byte("abc"), columnType: CommonTypeString, want: "abc"},
+		{
+			name:       "timestamps are compared in UTC",
+			value:      time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)),
+			columnType: CommonTypeDateTime,
+			want:       "2024-01-01T09:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NormalizeRowValue(tt.value, tt.columnType))
+		})
+	}
+}
@@ -51,6 +51,7 @@ func NewColumnCheckOperator(conn config.ConnectionGetter) *ColumnCheckOperator {
 			"positive":        func(c *connectionRemapper) CheckRunner { return ansisql.NewPositiveCheck(c) },
 			"non_negative":    func(c *connectionRemapper) CheckRunner { return ansisql.NewNonNegativeCheck(c) },
 			"negative":        func(c *connectionRemapper) CheckRunner { return ansisql.NewNegativeCheck(c) },
+			"relationships":   func(c *connectionRemapper) CheckRunner { return ansisql.NewRelationshipsCheck(c) },
 			"accepted_values": func(c *connectionRemapper) CheckRunner { return athena.NewAcceptedValuesCheck(c) },
 			"pattern":         func(c *connectionRemapper) CheckRunner { return athena.NewPatternCheck(c) },
 		},
@@ -188,8 +188,10 @@ func (w worker) run(ctx context.Context, taskChannel <-chan scheduler.TaskInstan
 		}
 
 		results <- &scheduler.TaskExecutionResult{
-			Instance: task,
-			Error:    err,
+			Instance:   task,
+			Error:      err,
+			StartedAt:  start,
+			FinishedAt: start.Add(duration),
 		}
 	}
 }
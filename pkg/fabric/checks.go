@@ -22,6 +22,7 @@ func NewColumnCheckOperator(manager config.ConnectionGetter) *ansisql.ColumnChec
 		"negative":        &NegativeCheck{conn: manager},
 		"min":             &MinCheck{conn: manager},
 		"max":             &MaxCheck{conn: manager},
+		"relationships":   &RelationshipsCheck{conn: manager},
 		"accepted_values": &AcceptedValuesCheck{conn: manager},
 		"pattern":         &PatternCheck{conn: manager},
 	})
@@ -96,9 +97,11 @@ func (c *UniqueCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInstan
 		QuoteIdentifier(ti.GetAsset().Name),
 	)
 
+	duplicates := ansisql.DuplicateValuesQuery(QuoteIdentifier(ti.GetAsset().Name), QuoteIdentifier(ti.Column.Name), "COUNT_BIG")
+
 	return ansisql.NewCountableQueryCheck(c.conn, 0, &query.Query{Query: qq}, "unique", func(count int64) error {
 		return errors.Errorf("column '%s' has %d non-unique values", ti.Column.Name, count)
-	}).Check(ctx, ti)
+	}).WithFailingRowsQuery(duplicates).Check(ctx, ti)
 }
 
 type NotNullCheck struct {
@@ -195,3 +198,25 @@ func thresholdSQLValue(intPtr *int, floatPtr *float64, stringPtr *string, checkN
 		return "", errors.Errorf("unexpected value for %s check, the value must be an int, float or string", checkName)
 	}
 }
+
+type RelationshipsCheck struct {
+	conn config.ConnectionGetter
+}
+
+func (c *RelationshipsCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInstance) error {
+	ref := ti.Check.Value.Reference
+	if ref == nil || ref.Asset == "" || ref.Column == "" {
+		return errors.New("unexpected value for relationships check, the value must have the referenced 'asset' and 'column'")
+	}
+
+	column := QuoteIdentifier(ti.Column.Name)
+	refColumn := QuoteIdentifier(ref.Column)
+	qq := fmt.Sprintf(
+		"SELECT COUNT_BIG(*) FROM %s WHERE %s IS NOT NULL AND %s NOT IN (SELECT %s FROM %s WHERE %s IS NOT NULL)",
+		QuoteIdentifier(ti.GetAsset().Name), column, column, refColumn, QuoteIdentifier(ref.Asset), refColumn,
+	)
+
+	return ansisql.NewCountableQueryCheck(c.conn, 0, &query.Query{Query: qq}, "relationships", func(count int64) error {
+		return errors.Errorf("column '%s' has %d values that do not exist in '%s.%s'", ti.Column.Name, count, ref.Asset, ref.Column)
+	}).Check(ctx, ti)
+}
@@ -218,6 +218,11 @@ WHERE TABLE_SCHEMA NOT IN ('sys', 'INFORMATION_SCHEMA')
 	return summary, nil
 }
 
+func (db *DB) Limit(query string, limit int64) string {
+	query = strings.TrimRight(query, "; \n\t")
+	return fmt.Sprintf("SELECT TOP %d * FROM (\n%s\n) as t", limit, query)
+}
+
 func (db *DB) GetIngestrURI() (string, error) {
 	return db.config.GetIngestrURI(), nil
 }
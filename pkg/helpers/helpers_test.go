@@ -568,3 +568,37 @@ func TestCastResultToInteger(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureReadOnlyQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		query      string
+		statements []string
+		wantErr    string
+	}{
+		{name: "select", query: "SELECT * FROM orders;", statements: []string{"select", "with"}},
+		{name: "semicolon in a literal", query: "select * from logs where message = 'a; b'", statements: []string{"select", "with"}},
+		{name: "keyword in a comment", query: "-- delete later\nselect 1", statements: []string{"select"}},
+		{name: "statement not allowed", query: "describe orders", statements: []string{"select", "with"}, wantErr: "only read-only queries are allowed, the query must start with SELECT or WITH"},
+		{name: "single statement allowed", query: "show tables", statements: []string{"select"}, wantErr: "only read-only queries are allowed, the query must start with SELECT"},
+		{name: "multiple statements", query: "select 1; select 2", statements: []string{"select"}, wantErr: "only a single statement is allowed"},
+		{name: "data-modifying CTE", query: "with d as (delete from orders returning *) select * from d", statements: []string{"select", "with"}, wantErr: "only read-only queries are allowed, found 'DELETE'"},
+		{name: "select into", query: "select * into orders_copy from orders", statements: []string{"select"}, wantErr: "only read-only queries are allowed, found 'INTO'"},
+		{name: "empty", query: " ; ", statements: []string{"select"}, wantErr: "query is empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := EnsureReadOnlyQuery(tt.query, tt.statements...)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	sqlCommentPattern        = regexp.MustCompile(`(?s)--[^\n]*|/\*.*?\*/`)
+	sqlLiteralPattern        = regexp.MustCompile(`'(?:[^']|'')*'`)
+	sqlLeadingKeywordPattern = regexp.MustCompile(`^\w+`)
+	writeKeywordPattern      = regexp.MustCompile(`(?i)\b(insert|update|delete|merge|upsert|drop|create|alter|truncate|grant|revoke|copy|call|exec|execute|attach|detach|vacuum|use|into|unload|export\s+data)\b`)
+	// REPLACE is also a string function and a BigQuery SELECT modifier, it only writes when no parenthesis follows it
+	replaceStatementPattern = regexp.MustCompile(`(?i)\b(replace)\b\s*(?:[^\s(]|$)`)
+)
+
+// EnsureReadOnlyQuery rejects anything other than a single statement that starts with one of the given keywords and
+// does not write anywhere, e.g. through a data-modifying CTE or `SELECT ... INTO`. The comments and string literals are
+// ignored, so a keyword or a semicolon inside a string does not count. This is a safeguard, the credentials of the
+// connection should still be read-only.
+func EnsureReadOnlyQuery(query string, statements ...string) error {
+	stripped := sqlCommentPattern.ReplaceAllString(query, " ")
+	stripped = sqlLiteralPattern.ReplaceAllString(stripped, "''")
+	stripped = strings.TrimSpace(stripped)
+	stripped = strings.TrimSpace(strings.TrimSuffix(stripped, ";"))
+
+	if stripped == "" {
+		return errors.New("query is empty")
+	}
+
+	if strings.Contains(stripped, ";") {
+		return errors.New("only a single statement is allowed")
+	}
+
+	if !slices.Contains(statements, strings.ToLower(sqlLeadingKeywordPattern.FindString(stripped))) {
+		return errors.Errorf("only read-only queries are allowed, the query must start with %s", keywordList(statements))
+	}
+
+	for _, pattern := range []*regexp.Regexp{replaceStatementPattern, writeKeywordPattern} {
+		if match := pattern.FindStringSubmatch(stripped); match != nil {
+			keyword := strings.Join(strings.Fields(match[1]), " ")
+			return errors.Errorf("only read-only queries are allowed, found '%s'", strings.ToUpper(keyword))
+		}
+	}
+
+	return nil
+}
+
+func keywordList(keywords []string) string {
+	upper := make([]string, len(keywords))
+	for i, keyword := range keywords {
+		upper[i] = strings.ToUpper(keyword)
+	}
+	if len(upper) < 2 {
+		return strings.Join(upper, "")
+	}
+
+	return strings.Join(upper[:len(upper)-1], ", ") + " or " + upper[len(upper)-1]
+}
@@ -0,0 +1,155 @@
+package jinja
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/helpers"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/nikolalohinski/gonja/v2/exec"
+	"github.com/pkg/errors"
+)
+
+// Introspector runs the queries of the run_query and get_column_values functions on the given connection, the
+// renderer only knows this interface since the connections cannot be reached from this package.
+type Introspector interface {
+	Query(ctx context.Context, connName, sql string) (columns []string, rows [][]any, err error)
+}
+
+// sqlIdentifierPattern matches a part of a table or column name, either bare or quoted in one of the styles of the
+// supported platforms; get_column_values only accepts names so that no SQL can be slipped into its query.
+var sqlIdentifierPattern = regexp.MustCompile("^(?:[A-Za-z_][\\w$-]*|\"[^\"]+\"|`[^`]+`|\\[[^\\]]+\\])$")
+
+// introspectionFunctions returns run_query and get_column_values bound to the connection of the asset. Without an
+// introspector the functions are stubs that return no rows, so that the queries can be rendered without a connection,
+// e.g. by `bruin render` or the validation.
+func introspectionFunctions(ctx context.Context, introspector Introspector, pipe *pipeline.Pipeline, asset *pipeline.Asset, schemaPrefix string) (func(*exec.VarArgs) ([]map[string]any, error), func(*exec.VarArgs) ([]any, error)) {
+	run := func(sql string) ([]string, [][]any, error) {
+		if introspector == nil || pipe == nil || asset == nil {
+			return nil, nil, nil
+		}
+
+		connName, err := pipe.GetConnectionNameForAsset(asset)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return introspector.Query(ctx, connName, sql)
+	}
+
+	runQuery := func(params *exec.VarArgs) ([]map[string]any, error) {
+		args, ok := stringArgs(params)
+		if !ok || len(args) != 1 {
+			return nil, errors.New("run_query() requires a query")
+		}
+		// rendering a query must never change the data
+		if err := helpers.EnsureReadOnlyQuery(args[0], "select", "with"); err != nil {
+			return nil, errors.Wrap(err, "run_query()")
+		}
+
+		columns, resultRows, err := run(args[0])
+		if err != nil {
+			return nil, errors.Wrap(err, "run_query() failed")
+		}
+
+		rows := make([]map[string]any, len(resultRows))
+		for r, row := range resultRows {
+			rows[r] = make(map[string]any, len(columns))
+			for c, column := range columns {
+				if c < len(row) {
+					rows[r][column] = row[c]
+				}
+			}
+		}
+
+		return rows, nil
+	}
+
+	getColumnValues := func(params *exec.VarArgs) ([]any, error) {
+		maxValues := 0
+		for key, value := range params.KwArgs {
+			if key != "max_values" || !value.IsInteger() || value.Integer() < 1 {
+				return nil, errors.New("get_column_values() only accepts a positive max_values as a keyword argument")
+			}
+			maxValues = value.Integer()
+		}
+
+		args, ok := stringArgs(&exec.VarArgs{Args: params.Args})
+		if !ok || len(args) != 2 {
+			return nil, errors.New("get_column_values() requires a table and a column")
+		}
+
+		table, column := args[0], args[1]
+		if !isSQLIdentifier(table, 3) || !isSQLIdentifier(column, 1) {
+			return nil, errors.New("get_column_values() requires the name of a table and of a column")
+		}
+		// the assets of the pipeline are read from the developer environment, like ref() does
+		if pipe != nil {
+			if resolved, ok := assetTable(pipe, table, schemaPrefix); ok {
+				table = resolved
+			}
+		}
+
+		var assetType pipeline.AssetType
+		if asset != nil {
+			assetType = asset.Type
+		}
+		query := distinctValuesQuery(assetType, table, column, maxValues)
+		if err := helpers.EnsureReadOnlyQuery(query, "select"); err != nil {
+			return nil, errors.Wrap(err, "get_column_values()")
+		}
+
+		_, rows, err := run(query)
+		if err != nil {
+			return nil, errors.Wrap(err, "get_column_values() failed")
+		}
+
+		values := make([]any, 0, len(rows))
+		for _, row := range rows {
+			if len(row) == 0 {
+				continue
+			}
+			values = append(values, row[0])
+		}
+
+		return values, nil
+	}
+
+	return runQuery, getColumnValues
+}
+
+var runQueryStub, getColumnValuesStub = introspectionFunctions(context.Background(), nil, nil, nil, "")
+
+func isSQLIdentifier(name string, maxParts int) bool {
+	parts := strings.Split(name, ".")
+	if len(parts) > maxParts {
+		return false
+	}
+
+	for _, part := range parts {
+		if !sqlIdentifierPattern.MatchString(part) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// distinctValuesQuery builds the query of get_column_values, the limit goes after the ordering in the same query so
+// that max_values keeps the first values rather than any of them.
+func distinctValuesQuery(assetType pipeline.AssetType, table, column string, limit int) string {
+	if limit <= 0 {
+		return fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s IS NOT NULL ORDER BY %s", column, table, column, column)
+	}
+
+	switch strings.SplitN(string(assetType), ".", 2)[0] {
+	case "ms", "synapse", "fabric", "fw":
+		return fmt.Sprintf("SELECT DISTINCT TOP %d %s FROM %s WHERE %s IS NOT NULL ORDER BY %s", limit, column, table, column, column)
+	case "oracle":
+		return fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s IS NOT NULL ORDER BY %s FETCH FIRST %d ROWS ONLY", column, table, column, column, limit)
+	default:
+		return fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s IS NOT NULL ORDER BY %s LIMIT %d", column, table, column, column, limit)
+	}
+}
@@ -20,6 +20,7 @@ type Renderer struct {
 	context         *exec.Context
 	queryRenderLock *sync.Mutex
 	macroContent    string
+	introspector    Introspector
 }
 
 func init() { //nolint: gochecknoinits
@@ -29,6 +30,7 @@ func init() { //nolint: gochecknoinits
 var (
 	missingVariableRegex = regexp.MustCompile(`name\s+"([^"]+)"`)
 	locationRegex        = regexp.MustCompile(`\(Line: \d+ Col: \d+, near ".*?"\)`)
+	functionErrorRegex   = regexp.MustCompile(`invalid call to function '(?:ref|source|run_query|get_column_values)': (.*)$`)
 )
 
 type Context map[string]any
@@ -126,6 +128,12 @@ func (r *Renderer) SetContextValue(key string, value any) {
 	r.context.Set(key, value)
 }
 
+// SetIntrospector lets run_query and get_column_values query the connections of the assets, the renderers cloned for
+// the assets afterwards use it; without it the functions return no rows.
+func (r *Renderer) SetIntrospector(introspector Introspector) {
+	r.introspector = introspector
+}
+
 // NewRendererWithStartEndDatesAndMacros creates a new Renderer with the given dates, context, and macro content.
 func NewRendererWithStartEndDatesAndMacros(startDate, endDate, executionDate *time.Time, pipelineName, runID string, vars Context, macroContent string) *Renderer {
 	ctx := defaultContext(startDate, endDate, executionDate, pipelineName, runID, false)
@@ -156,6 +164,10 @@ func defaultContext(startDate, endDate, executionDate *time.Time, pipelineName,
 		"full_refresh":          fullRefresh,
 		"commit_hash":           "",
 		"schema_prefix":         "",
+		"ref":                   refFunction(nil, ""),
+		"source":                sourceFunction,
+		"run_query":             runQueryStub,
+		"get_column_values":     getColumnValuesStub,
 	}
 }
 
@@ -277,16 +289,23 @@ func (r *Renderer) CloneForAsset(ctx context.Context, pipe *pipeline.Pipeline, a
 
 	jinjaContext := defaultContext(&startDate, &endDate, &executionDate, pipe.Name, ctx.Value(pipeline.RunConfigRunID).(string), fullRefresh)
 	jinjaContext["this"] = asset.Name
+	jinjaContext["asset"] = assetMetadata(asset)
+	jinjaContext["pipeline_metadata"] = pipelineMetadata(pipe)
 	jinjaContext["var"] = pipe.Variables.Value()
 	jinjaContext["commit_hash"] = pipe.Commit
+	schemaPrefix := ""
 	if env, ok := ctx.Value(config.EnvironmentContextKey).(*config.Environment); ok && env != nil {
-		jinjaContext["schema_prefix"] = env.SchemaPrefix
+		schemaPrefix = env.SchemaPrefix
 	}
+	jinjaContext["schema_prefix"] = schemaPrefix
+	jinjaContext["ref"] = refFunction(pipe, schemaPrefix)
+	jinjaContext["run_query"], jinjaContext["get_column_values"] = introspectionFunctions(ctx, r.introspector, pipe, asset, schemaPrefix)
 
 	return &Renderer{
 		context:         exec.NewContext(jinjaContext),
 		queryRenderLock: &sync.Mutex{},
 		macroContent:    r.macroContent, // Preserve macro content when cloning
+		introspector:    r.introspector,
 	}, nil
 }
 
@@ -297,6 +316,8 @@ func findRenderErrorType(err error) string {
 
 	if strings.HasPrefix(innermostErr, "filter '") && strings.HasSuffix(innermostErr, "' not found") {
 		return innermostErr
+	} else if match := functionErrorRegex.FindStringSubmatch(message); match != nil {
+		return match[1]
 	} else if strings.HasPrefix(innermostErr, "Unable to evaluate name ") {
 		match := missingVariableRegex.FindStringSubmatch(innermostErr)
 		if len(match) <= 2 {
@@ -2,6 +2,7 @@ package jinja
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -1414,3 +1415,396 @@ func TestRenderer_SchemaPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderer_RefAndSource(t *testing.T) {
+	t.Parallel()
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	executionDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		assetNames []string
+		env        *config.Environment
+		query      string
+		want       string
+		wantErr    string
+	}{
+		{
+			name:       "ref resolves to the asset",
+			assetNames: []string{"raw.orders", "mart.revenue"},
+			query:      "SELECT * FROM {{ ref('raw.orders') }}",
+			want:       "SELECT * FROM raw.orders",
+		},
+		{
+			name:       "ref adds the schema prefix of the environment",
+			assetNames: []string{"raw.orders", "mart.revenue"},
+			env:        &config.Environment{SchemaPrefix: "dev_"},
+			query:      "SELECT * FROM {{ ref('raw.orders') }}",
+			want:       "SELECT * FROM dev_raw.orders",
+		},
+		{
+			name:       "ref finds the assets already renamed for the environment",
+			assetNames: []string{"dev_raw.orders", "dev_mart.revenue"},
+			env:        &config.Environment{SchemaPrefix: "dev_"},
+			query:      "SELECT * FROM {{ ref('raw.orders') }}",
+			want:       "SELECT * FROM dev_raw.orders",
+		},
+		{
+			name:       "ref prefixes the schemas that start with the prefix",
+			assetNames: []string{"dev_tools.users", "mart.revenue"},
+			env:        &config.Environment{SchemaPrefix: "dev_"},
+			query:      "SELECT * FROM {{ ref('dev_tools.users') }}",
+			want:       "SELECT * FROM dev_dev_tools.users",
+		},
+		{
+			name:       "ref finds the renamed assets whose schema starts with the prefix",
+			assetNames: []string{"dev_dev_tools.users", "dev_mart.revenue"},
+			env:        &config.Environment{SchemaPrefix: "dev_"},
+			query:      "SELECT * FROM {{ ref('dev_tools.users') }}",
+			want:       "SELECT * FROM dev_dev_tools.users",
+		},
+		{
+			name:       "ref with a computed name",
+			assetNames: []string{"acme_raw.orders", "mart.revenue"},
+			query:      "SELECT * FROM {{ ref(var.client ~ '_raw.orders') }}",
+			want:       "SELECT * FROM acme_raw.orders",
+		},
+		{
+			name:       "ref to an unknown asset fails",
+			assetNames: []string{"mart.revenue"},
+			query:      "SELECT * FROM {{ ref('raw.orders') }}",
+			wantErr:    "ref('raw.orders') does not match any asset in the pipeline",
+		},
+		{
+			name:       "source is never prefixed",
+			assetNames: []string{"mart.revenue"},
+			env:        &config.Environment{SchemaPrefix: "dev_"},
+			query:      "SELECT * FROM {{ source('landing', 'orders') }} JOIN {{ source('landing.customers') }}",
+			want:       "SELECT * FROM landing.orders JOIN landing.customers",
+		},
+		{
+			name:       "source without a table fails",
+			assetNames: []string{"mart.revenue"},
+			query:      "SELECT * FROM {{ source() }}",
+			wantErr:    "source() requires the name of a table, or the name of a schema and a table",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			basePipeline := &pipeline.Pipeline{
+				Name:      "test-pipeline",
+				Variables: pipeline.Variables{"client": map[string]any{"type": "string", "default": "acme"}},
+			}
+			for _, name := range tt.assetNames {
+				basePipeline.Assets = append(basePipeline.Assets, &pipeline.Asset{Name: name})
+			}
+
+			ctx := t.Context()
+			ctx = context.WithValue(ctx, pipeline.RunConfigStartDate, startDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigEndDate, endDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigExecutionDate, executionDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigRunID, "test-run-id")
+			if tt.env != nil {
+				ctx = context.WithValue(ctx, config.EnvironmentContextKey, tt.env)
+			}
+
+			baseRenderer := NewRendererWithStartEndDates(&startDate, &endDate, &executionDate, basePipeline.Name, "test-run-id", nil)
+			clonedRenderer, err := baseRenderer.CloneForAsset(ctx, basePipeline, basePipeline.Assets[len(basePipeline.Assets)-1])
+			require.NoError(t, err)
+
+			result, err := clonedRenderer.Render(tt.query)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestRenderer_RefWithoutPipeline(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewRendererWithYesterday("test-pipeline", "test-run-id").Render("SELECT * FROM {{ ref('raw.orders') }}")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM raw.orders", result)
+}
+
+type fakeIntrospector struct {
+	queries []string
+	conns   []string
+	columns []string
+	rows    [][]any
+	err     error
+}
+
+func (f *fakeIntrospector) Query(ctx context.Context, connName, sql string) ([]string, [][]any, error) {
+	f.queries = append(f.queries, sql)
+	f.conns = append(f.conns, connName)
+	return f.columns, f.rows, f.err
+}
+
+func TestRenderer_IntrospectionFunctions(t *testing.T) {
+	t.Parallel()
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	executionDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		query       string
+		columns     []string
+		rows        [][]any
+		queryErr    error
+		want        string
+		env         *config.Environment
+		wantErr     string
+		wantQueries []string
+	}{
+		{
+			name:        "run_query returns the rows by column",
+			query:       "{% for row in run_query('SELECT id, name FROM raw.users') %}{{ row.id }}={{ row.name }};{% endfor %}",
+			columns:     []string{"id", "name"},
+			rows:        [][]any{{1, "a"}, {2, "b"}},
+			want:        "1=a;2=b;",
+			wantQueries: []string{"SELECT id, name FROM raw.users"},
+		},
+		{
+			name:        "get_column_values builds the pivot",
+			query:       "SELECT {% for m in get_column_values('raw.payments', 'method') %}SUM(CASE WHEN method = '{{ m }}' THEN amount END) AS {{ m }}_amount{% if not loop.last %}, {% endif %}{% endfor %} FROM raw.payments",
+			columns:     []string{"method"},
+			rows:        [][]any{{"card"}, {"cash"}},
+			want:        "SELECT SUM(CASE WHEN method = 'card' THEN amount END) AS card_amount, SUM(CASE WHEN method = 'cash' THEN amount END) AS cash_amount FROM raw.payments",
+			wantQueries: []string{"SELECT DISTINCT method FROM raw.payments WHERE method IS NOT NULL ORDER BY method"},
+		},
+		{
+			name:        "get_column_values limits the query to max_values",
+			query:       "{{ get_column_values('raw.payments', 'method', max_values=1) | join(',') }}",
+			columns:     []string{"method"},
+			rows:        [][]any{{"card"}},
+			want:        "card",
+			wantQueries: []string{"SELECT DISTINCT method FROM raw.payments WHERE method IS NOT NULL ORDER BY method LIMIT 1"},
+		},
+		{
+			name:        "get_column_values reads the assets from the developer environment",
+			query:       "{{ get_column_values('raw.payments', 'method') | join(',') }}",
+			env:         &config.Environment{SchemaPrefix: "dev_"},
+			columns:     []string{"method"},
+			rows:        [][]any{{"card"}},
+			want:        "card",
+			wantQueries: []string{"SELECT DISTINCT method FROM dev_raw.payments WHERE method IS NOT NULL ORDER BY method"},
+		},
+		{
+			name:    "get_column_values only accepts names",
+			query:   "{{ get_column_values('raw.payments; DROP TABLE raw.payments', 'method') }}",
+			wantErr: "get_column_values() requires the name of a table and of a column",
+		},
+		{
+			name:    "get_column_values refuses the names that write",
+			query:   "{{ get_column_values('raw.payments', '\"method INTO payments_copy\"') }}",
+			wantErr: "get_column_values(): only read-only queries are allowed, found 'INTO'",
+		},
+		{
+			name:    "run_query refuses the queries that write",
+			query:   "{{ run_query('DELETE FROM raw.users') }}",
+			wantErr: "run_query(): only read-only queries are allowed, the query must start with SELECT or WITH",
+		},
+		{
+			name:    "run_query refuses multiple statements",
+			query:   "{{ run_query('SELECT 1; DROP TABLE raw.users') }}",
+			wantErr: "run_query(): only a single statement is allowed",
+		},
+		{
+			name:    "run_query refuses the CTEs that write",
+			query:   "{{ run_query('WITH d AS (DELETE FROM raw.users RETURNING *) SELECT * FROM d') }}",
+			wantErr: "run_query(): only read-only queries are allowed, found 'DELETE'",
+		},
+		{
+			name:    "run_query refuses SELECT INTO",
+			query:   "{{ run_query('SELECT * INTO raw.users_copy FROM raw.users') }}",
+			wantErr: "run_query(): only read-only queries are allowed, found 'INTO'",
+		},
+		{
+			name:        "run_query allows a semicolon in a string",
+			query:       "{% for row in run_query(\"SELECT id FROM raw.logs WHERE message = 'a; b';\") %}{{ row.id }}{% endfor %}",
+			columns:     []string{"id"},
+			rows:        [][]any{{1}},
+			want:        "1",
+			wantQueries: []string{"SELECT id FROM raw.logs WHERE message = 'a; b';"},
+		},
+		{
+			name:        "the errors of the query are reported",
+			query:       "{{ run_query('SELECT * FROM raw.missing') }}",
+			queryErr:    errors.New("table raw.missing: not found"),
+			wantErr:     "run_query() failed: table raw.missing: not found",
+			wantQueries: []string{"SELECT * FROM raw.missing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			asset := &pipeline.Asset{Name: "mart.payments", Type: pipeline.AssetTypeDuckDBQuery, Connection: "analytics-db"}
+			basePipeline := &pipeline.Pipeline{Name: "test-pipeline", Assets: []*pipeline.Asset{{Name: "raw.payments"}, asset}}
+
+			ctx := t.Context()
+			ctx = context.WithValue(ctx, pipeline.RunConfigStartDate, startDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigEndDate, endDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigExecutionDate, executionDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigRunID, "test-run-id")
+			if tt.env != nil {
+				ctx = context.WithValue(ctx, config.EnvironmentContextKey, tt.env)
+			}
+
+			introspector := &fakeIntrospector{columns: tt.columns, rows: tt.rows, err: tt.queryErr}
+			baseRenderer := NewRendererWithStartEndDates(&startDate, &endDate, &executionDate, basePipeline.Name, "test-run-id", nil)
+			baseRenderer.SetIntrospector(introspector)
+
+			clonedRenderer, err := baseRenderer.CloneForAsset(ctx, basePipeline, asset)
+			require.NoError(t, err)
+
+			result, err := clonedRenderer.Render(tt.query)
+			require.Equal(t, tt.wantQueries, introspector.queries)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, result)
+			for _, conn := range introspector.conns {
+				require.Equal(t, "analytics-db", conn)
+			}
+		})
+	}
+}
+
+func TestDistinctValuesQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		assetType pipeline.AssetType
+		limit     int
+		want      string
+	}{
+		{assetType: pipeline.AssetTypeBigqueryQuery, want: "SELECT DISTINCT method FROM raw.payments WHERE method IS NOT NULL ORDER BY method"},
+		{assetType: pipeline.AssetTypeBigqueryQuery, limit: 5, want: "SELECT DISTINCT method FROM raw.payments WHERE method IS NOT NULL ORDER BY method LIMIT 5"},
+		{assetType: pipeline.AssetTypeMsSQLQuery, limit: 5, want: "SELECT DISTINCT TOP 5 method FROM raw.payments WHERE method IS NOT NULL ORDER BY method"},
+		{assetType: pipeline.AssetTypeFabricQuery, limit: 5, want: "SELECT DISTINCT TOP 5 method FROM raw.payments WHERE method IS NOT NULL ORDER BY method"},
+		{assetType: pipeline.AssetTypeOracleQuery, limit: 5, want: "SELECT DISTINCT method FROM raw.payments WHERE method IS NOT NULL ORDER BY method FETCH FIRST 5 ROWS ONLY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.assetType, tt.limit), func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, distinctValuesQuery(tt.assetType, "raw.payments", "method", tt.limit))
+		})
+	}
+}
+
+func TestRenderer_IntrospectionStubs(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewRendererWithYesterday("test-pipeline", "test-run-id").Render(
+		"SELECT id{% for m in get_column_values('raw.payments', 'method') %}, {{ m }}{% endfor %}{% for row in run_query('SELECT 1 AS x') %}, {{ row.x }}{% endfor %} FROM raw.payments",
+	)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT id FROM raw.payments", result)
+}
+
+func TestRenderer_AssetMetadata(t *testing.T) {
+	t.Parallel()
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	executionDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	notNullable := false
+
+	asset := &pipeline.Asset{
+		Name:       "mart.customers",
+		Type:       "bq.sql",
+		Owner:      "data@acme.com",
+		Tags:       []string{"core"},
+		Meta:       map[string]string{"team": "growth"},
+		Parameters: map[string]string{"lookback": "7"},
+		Materialization: pipeline.Materialization{
+			Type:           pipeline.MaterializationTypeTable,
+			Strategy:       pipeline.MaterializationStrategyDeleteInsert,
+			IncrementalKey: "updated_at",
+		},
+		Columns: []pipeline.Column{
+			{Name: "id", Type: "INTEGER", PrimaryKey: true, Nullable: pipeline.DefaultTrueBool{Value: &notNullable}},
+			{Name: "email", Type: "STRING", Tags: []string{"pii"}},
+			{Name: "updated_at", Type: "TIMESTAMP"},
+		},
+	}
+	pipe := &pipeline.Pipeline{
+		Name:     "test-pipeline",
+		Owner:    "platform@acme.com",
+		Schedule: "daily",
+		Meta:     map[string]string{"region": "eu"},
+		Assets:   []*pipeline.Asset{asset},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "non-pii columns",
+			query: "SELECT {% for column in asset.columns if 'pii' not in column.tags %}{{ column.name }}{% if not loop.last %}, {% endif %}{% endfor %} FROM {{ this }}",
+			want:  "SELECT id, updated_at FROM mart.customers",
+		},
+		{
+			name:  "dedup over the primary key",
+			query: "QUALIFY ROW_NUMBER() OVER (PARTITION BY {{ asset.primary_keys | join(', ') }} ORDER BY {{ asset.materialization.incremental_key }} DESC) = 1",
+			want:  "QUALIFY ROW_NUMBER() OVER (PARTITION BY id ORDER BY updated_at DESC) = 1",
+		},
+		{
+			name:  "column types and nullability",
+			query: "{% for column in asset.columns %}{{ column.name }} {{ column.type }}{% if not column.nullable %} NOT NULL{% endif %};{% endfor %}",
+			want:  "id INTEGER NOT NULL;email STRING;updated_at TIMESTAMP;",
+		},
+		{
+			name:  "asset fields",
+			query: "{{ asset.type }} {{ asset.owner }} {{ asset.tags | join(',') }} {{ asset.meta.team }} {{ asset.parameters.lookback }} {{ asset.materialization.type }} {{ asset.materialization.strategy }} {{ asset.column_names | join(',') }}",
+			want:  "bq.sql data@acme.com core growth 7 table delete+insert id,email,updated_at",
+		},
+		{
+			name:  "pipeline metadata",
+			query: "{{ pipeline }} {{ pipeline_metadata.owner }} {{ pipeline_metadata.schedule }} {{ pipeline_metadata.meta.region }} {{ pipeline_metadata.tags | length }}",
+			want:  "test-pipeline platform@acme.com daily eu 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			ctx = context.WithValue(ctx, pipeline.RunConfigStartDate, startDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigEndDate, endDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigExecutionDate, executionDate)
+			ctx = context.WithValue(ctx, pipeline.RunConfigRunID, "test-run-id")
+
+			baseRenderer := NewRendererWithStartEndDates(&startDate, &endDate, &executionDate, pipe.Name, "test-run-id", nil)
+			clonedRenderer, err := baseRenderer.CloneForAsset(ctx, pipe, asset)
+			require.NoError(t, err)
+
+			result, err := clonedRenderer.Render(tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, result)
+		})
+	}
+}
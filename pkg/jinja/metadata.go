@@ -0,0 +1,83 @@
+package jinja
+
+import (
+	"github.com/bruin-data/bruin/pkg/pipeline"
+)
+
+// assetMetadata exposes the definition of the asset to the templates as `asset`, e.g. to select the columns without
+// repeating them in the query: `{% for column in asset.columns %}`.
+func assetMetadata(asset *pipeline.Asset) map[string]any {
+	columns := make([]map[string]any, 0, len(asset.Columns))
+	columnNames := make([]string, 0, len(asset.Columns))
+	primaryKeys := make([]string, 0)
+	for _, column := range asset.Columns {
+		columns = append(columns, map[string]any{
+			"name":            column.Name,
+			"type":            column.Type,
+			"description":     column.Description,
+			"tags":            stringList(column.Tags),
+			"domains":         stringList(column.Domains),
+			"meta":            stringMap(column.Meta),
+			"owner":           column.Owner,
+			"primary_key":     column.PrimaryKey,
+			"nullable":        column.Nullable.Value == nil || *column.Nullable.Value,
+			"update_on_merge": column.UpdateOnMerge,
+		})
+		columnNames = append(columnNames, column.Name)
+		if column.PrimaryKey {
+			primaryKeys = append(primaryKeys, column.Name)
+		}
+	}
+
+	return map[string]any{
+		"name":         asset.Name,
+		"type":         string(asset.Type),
+		"description":  asset.Description,
+		"connection":   asset.Connection,
+		"owner":        asset.Owner,
+		"tags":         stringList(asset.Tags),
+		"domains":      stringList(asset.Domains),
+		"meta":         stringMap(asset.Meta),
+		"parameters":   stringMap(asset.Parameters),
+		"columns":      columns,
+		"column_names": columnNames,
+		"primary_keys": primaryKeys,
+		"materialization": map[string]any{
+			"type":             string(asset.Materialization.Type),
+			"strategy":         string(asset.Materialization.Strategy),
+			"partition_by":     asset.Materialization.PartitionBy,
+			"cluster_by":       stringList(asset.Materialization.ClusterBy),
+			"incremental_key":  asset.Materialization.IncrementalKey,
+			"time_granularity": string(asset.Materialization.TimeGranularity),
+		},
+	}
+}
+
+// pipelineMetadata exposes the definition of the pipeline as `pipeline_metadata`, `pipeline` is only its name.
+func pipelineMetadata(pipe *pipeline.Pipeline) map[string]any {
+	return map[string]any{
+		"name":     pipe.Name,
+		"owner":    pipe.Owner,
+		"schedule": string(pipe.Schedule),
+		"tags":     stringList(pipe.Tags),
+		"domains":  stringList(pipe.Domains),
+		"meta":     stringMap(pipe.Meta),
+	}
+}
+
+// stringList and stringMap never return nil, so that the templates can loop over and test the values of empty fields.
+func stringList(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func stringMap(values map[string]string) map[string]string {
+	if values == nil {
+		return map[string]string{}
+	}
+
+	return values
+}
@@ -0,0 +1,78 @@
+package jinja
+
+import (
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/nikolalohinski/gonja/v2/exec"
+	"github.com/pkg/errors"
+)
+
+// refFunction resolves ref('asset_name') to the table of the asset, including the schema prefix of the developer
+// environment. Without a pipeline the name is only prefixed, e.g. while parsing the query for the lineage.
+func refFunction(pipe *pipeline.Pipeline, schemaPrefix string) func(*exec.VarArgs) (string, error) {
+	return func(params *exec.VarArgs) (string, error) {
+		args, ok := stringArgs(params)
+		if !ok || len(args) != 1 {
+			return "", errors.New("ref() requires the name of an asset")
+		}
+		name := args[0]
+
+		if pipe == nil {
+			return pipeline.PrefixSchemaName(name, schemaPrefix), nil
+		}
+
+		table, ok := assetTable(pipe, name, schemaPrefix)
+		if !ok {
+			return "", errors.Errorf("ref('%s') does not match any asset in the pipeline", name)
+		}
+
+		return table, nil
+	}
+}
+
+// assetTable returns the table of the asset with the given name in the developer environment. The names of the assets
+// are already rendered for variant pipelines, and already prefixed when the developer environment renamed them before
+// the run: the asset found by the name as given still needs the prefix, the one only found by the prefixed name has it.
+func assetTable(pipe *pipeline.Pipeline, name, schemaPrefix string) (string, bool) {
+	if asset := pipe.GetAssetByName(name); asset != nil {
+		return pipeline.PrefixSchemaName(asset.Name, schemaPrefix), true
+	}
+
+	if schemaPrefix != "" {
+		if asset := pipe.GetAssetByName(pipeline.PrefixSchemaName(name, schemaPrefix)); asset != nil {
+			return asset.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// sourceFunction resolves source('schema.table') or source('schema', 'table') to the table as is: the sources are not
+// built by the pipeline, so they are never prefixed.
+func sourceFunction(params *exec.VarArgs) (string, error) {
+	parts, ok := stringArgs(params)
+	if !ok || len(parts) == 0 || len(parts) > 2 {
+		return "", errors.New("source() requires the name of a table, or the name of a schema and a table")
+	}
+
+	return strings.Join(parts, "."), nil
+}
+
+// stringArgs returns the positional arguments of the call, they must all be non-empty strings.
+func stringArgs(params *exec.VarArgs) ([]string, bool) {
+	if len(params.KwArgs) > 0 {
+		return nil, false
+	}
+
+	args := make([]string, 0, len(params.Args))
+	for _, arg := range params.Args {
+		value := strings.TrimSpace(arg.String())
+		if !arg.IsString() || value == "" {
+			return nil, false
+		}
+		args = append(args, value)
+	}
+
+	return args, true
+}
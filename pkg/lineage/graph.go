@@ -0,0 +1,344 @@
+package lineage
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+)
+
+// GraphNode is either an asset, or a column of an asset when Column is set. External nodes are the tables that are
+// not assets of the pipeline, e.g. the `uri` dependencies or the tables read by the queries.
+type GraphNode struct {
+	ID       string             `json:"-"`
+	Asset    string             `json:"asset"`
+	Column   string             `json:"column,omitempty"`
+	Type     pipeline.AssetType `json:"type,omitempty"`
+	External bool               `json:"external,omitempty"`
+}
+
+type GraphEdge struct {
+	From *GraphNode
+	To   *GraphNode
+}
+
+// Graph is the lineage between a selection of assets, or between their columns, that can be rendered as a diagram.
+type Graph struct {
+	Nodes []*GraphNode
+	Edges []*GraphEdge
+
+	nodesByID  map[string]*GraphNode
+	upstream   map[*GraphNode][]*GraphNode
+	downstream map[*GraphNode][]*GraphNode
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		Nodes:      make([]*GraphNode, 0),
+		Edges:      make([]*GraphEdge, 0),
+		nodesByID:  make(map[string]*GraphNode),
+		upstream:   make(map[*GraphNode][]*GraphNode),
+		downstream: make(map[*GraphNode][]*GraphNode),
+	}
+}
+
+// NewAssetGraph builds the graph of the dependencies between the given assets, the assets outside the selection are
+// left out while the external dependencies of the selected assets are kept.
+func NewAssetGraph(assets []*pipeline.Asset) *Graph {
+	g := newGraph()
+	selected := make(map[string]*GraphNode, len(assets))
+	for _, asset := range assets {
+		selected[strings.ToLower(asset.Name)] = g.addNode(&GraphNode{Asset: asset.Name, Type: asset.Type})
+	}
+
+	for _, asset := range assets {
+		node := selected[strings.ToLower(asset.Name)]
+		for _, upstream := range asset.Upstreams {
+			switch upstream.Type {
+			case "uri":
+				g.addEdge(g.addNode(&GraphNode{Asset: upstream.Value, External: true}), node)
+			case "", "asset":
+				if upstreamNode, ok := selected[strings.ToLower(upstream.Value)]; ok {
+					g.addEdge(upstreamNode, node)
+				}
+			}
+		}
+	}
+
+	return g
+}
+
+// NewColumnGraph builds the graph of the columns of the given assets from their column upstreams, which are only
+// known once the column lineage of the pipeline is extracted.
+func NewColumnGraph(p *pipeline.Pipeline, assets []*pipeline.Asset) *Graph {
+	g := newGraph()
+	selected := make(map[string]bool, len(assets))
+	for _, asset := range assets {
+		selected[strings.ToLower(asset.Name)] = true
+		for _, column := range asset.Columns {
+			g.addNode(&GraphNode{Asset: asset.Name, Column: column.Name, Type: asset.Type})
+		}
+	}
+
+	for _, asset := range assets {
+		for _, column := range asset.Columns {
+			node := g.ColumnNode(asset.Name, column.Name)
+			for _, upstream := range column.Upstreams {
+				if upstream == nil || upstream.Column == "" || upstream.Table == "" {
+					continue
+				}
+
+				upstreamAsset := p.GetAssetByName(upstream.Table)
+				if upstreamAsset == nil {
+					upstreamAsset = p.GetAssetByNameCaseInsensitive(upstream.Table)
+				}
+				if upstreamAsset == nil {
+					g.addEdge(g.addNode(&GraphNode{Asset: upstream.Table, Column: upstream.Column, External: true}), node)
+					continue
+				}
+				if !selected[strings.ToLower(upstreamAsset.Name)] {
+					continue
+				}
+
+				upstreamNode := g.ColumnNode(upstreamAsset.Name, upstream.Column)
+				if upstreamNode == nil {
+					upstreamNode = g.addNode(&GraphNode{Asset: upstreamAsset.Name, Column: upstream.Column, Type: upstreamAsset.Type})
+				}
+				g.addEdge(upstreamNode, node)
+			}
+		}
+	}
+
+	return g
+}
+
+func nodeID(asset, column string) string {
+	if column == "" {
+		return strings.ToLower(asset)
+	}
+
+	return strings.ToLower(asset) + "\x00" + strings.ToLower(column)
+}
+
+func (g *Graph) addNode(node *GraphNode) *GraphNode {
+	node.ID = nodeID(node.Asset, node.Column)
+	if existing, ok := g.nodesByID[node.ID]; ok {
+		return existing
+	}
+
+	g.nodesByID[node.ID] = node
+	g.Nodes = append(g.Nodes, node)
+	return node
+}
+
+func (g *Graph) addEdge(from, to *GraphNode) {
+	for _, existing := range g.downstream[from] {
+		if existing == to {
+			return
+		}
+	}
+
+	g.Edges = append(g.Edges, &GraphEdge{From: from, To: to})
+	g.downstream[from] = append(g.downstream[from], to)
+	g.upstream[to] = append(g.upstream[to], from)
+}
+
+// AssetNode returns the node of the asset, or nil if the asset is not in the graph.
+func (g *Graph) AssetNode(asset string) *GraphNode {
+	return g.nodesByID[nodeID(asset, "")]
+}
+
+// ColumnNode returns the node of the column of the asset, or nil if the column is not in the graph.
+func (g *Graph) ColumnNode(asset, column string) *GraphNode {
+	return g.nodesByID[nodeID(asset, column)]
+}
+
+// Upstream returns the nodes the given node depends on, the indirect ones are included as well when full is set.
+func (g *Graph) Upstream(node *GraphNode, full bool) []*GraphNode {
+	return g.walk(node, g.upstream, full)
+}
+
+// Downstream returns the nodes that depend on the given node, the indirect ones are included as well when full is set.
+func (g *Graph) Downstream(node *GraphNode, full bool) []*GraphNode {
+	return g.walk(node, g.downstream, full)
+}
+
+func (g *Graph) walk(node *GraphNode, next map[*GraphNode][]*GraphNode, full bool) []*GraphNode {
+	found := make([]*GraphNode, 0)
+	seen := map[*GraphNode]bool{node: true}
+	queue := []*GraphNode{node}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, n := range next[current] {
+			if seen[n] {
+				continue
+			}
+			seen[n] = true
+			found = append(found, n)
+			if full {
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	return found
+}
+
+// groups returns the nodes of a column graph grouped by their asset, in the order the assets appear.
+func (g *Graph) groups() ([]string, map[string][]*GraphNode) {
+	var assets []string
+	nodes := make(map[string][]*GraphNode)
+	for _, node := range g.Nodes {
+		key := strings.ToLower(node.Asset)
+		if _, ok := nodes[key]; !ok {
+			assets = append(assets, key)
+		}
+		nodes[key] = append(nodes[key], node)
+	}
+
+	return assets, nodes
+}
+
+func (g *Graph) isColumnGraph() bool {
+	for _, node := range g.Nodes {
+		if node.Column != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *GraphNode) label() string {
+	if n.Column != "" {
+		return n.Column
+	}
+
+	return n.Asset
+}
+
+// DOT renders the graph in the Graphviz format, the columns are grouped in a cluster per asset.
+func (g *Graph) DOT() string {
+	ids := make(map[*GraphNode]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[node] = fmt.Sprintf("n%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph lineage {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	writeNode := func(indent string, node *GraphNode) {
+		style := ""
+		if node.External {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "%s%s [label=%s%s];\n", indent, ids[node], dotQuote(node.label()), style)
+	}
+
+	if g.isColumnGraph() {
+		assets, nodes := g.groups()
+		for i, asset := range assets {
+			fmt.Fprintf(&b, "  subgraph cluster_%d {\n", i)
+			fmt.Fprintf(&b, "    label=%s;\n", dotQuote(nodes[asset][0].Asset))
+			if nodes[asset][0].External {
+				b.WriteString("    style=dashed;\n")
+			}
+			for _, node := range nodes[asset] {
+				writeNode("    ", node)
+			}
+			b.WriteString("  }\n")
+		}
+	} else {
+		for _, node := range g.Nodes {
+			writeNode("  ", node)
+		}
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s;\n", ids[edge.From], ids[edge.To])
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// Mermaid renders the graph as a Mermaid flowchart, the columns are grouped in a subgraph per asset.
+func (g *Graph) Mermaid() string {
+	ids := make(map[*GraphNode]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[node] = fmt.Sprintf("n%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	hasExternal := false
+	writeNode := func(indent string, node *GraphNode) {
+		class := ""
+		if node.External {
+			class = ":::external"
+			hasExternal = true
+		}
+		fmt.Fprintf(&b, "%s%s[%s]%s\n", indent, ids[node], mermaidQuote(node.label()), class)
+	}
+
+	if g.isColumnGraph() {
+		assets, nodes := g.groups()
+		for i, asset := range assets {
+			fmt.Fprintf(&b, "  subgraph g%d[%s]\n", i, mermaidQuote(nodes[asset][0].Asset))
+			for _, node := range nodes[asset] {
+				writeNode("    ", node)
+			}
+			b.WriteString("  end\n")
+		}
+	} else {
+		for _, node := range g.Nodes {
+			writeNode("  ", node)
+		}
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s --> %s\n", ids[edge.From], ids[edge.To])
+	}
+	if hasExternal {
+		b.WriteString("  classDef external stroke-dasharray: 5 5\n")
+	}
+
+	return b.String()
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
+
+// HTML renders the graph as a standalone page that draws the Mermaid flowchart in the browser.
+func (g *Graph) HTML(title string) string {
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>%s</title>
+  <style>
+    body { font-family: sans-serif; margin: 2rem; }
+  </style>
+</head>
+<body>
+  <h1>%s</h1>
+  <pre class="mermaid">
+%s</pre>
+  <script type="module">
+    import mermaid from "https://cdn.jsdelivr.net/npm/mermaid@11/dist/mermaid.esm.min.mjs";
+    mermaid.initialize({ startOnLoad: true, maxTextSize: 1000000 });
+  </script>
+</body>
+</html>
+`, html.EscapeString(title), html.EscapeString(title), html.EscapeString(g.Mermaid()))
+}
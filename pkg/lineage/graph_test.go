@@ -0,0 +1,153 @@
+package lineage
+
+import (
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func graphTestPipeline() *pipeline.Pipeline {
+	orders := &pipeline.Asset{
+		Name: "raw.orders",
+		Type: pipeline.AssetTypeBigqueryQuery,
+		Upstreams: []pipeline.Upstream{
+			{Type: "uri", Value: "bigquery://project/landing/orders"},
+		},
+		Columns: []pipeline.Column{
+			{Name: "id", Upstreams: []*pipeline.UpstreamColumn{{Table: "landing.orders", Column: "order_id"}}},
+			{Name: "amount", Upstreams: []*pipeline.UpstreamColumn{{Table: "landing.orders", Column: "amount"}}},
+		},
+	}
+	revenue := &pipeline.Asset{
+		Name:      "mart.revenue",
+		Type:      pipeline.AssetTypeBigqueryQuery,
+		Upstreams: []pipeline.Upstream{{Type: "asset", Value: "raw.orders"}},
+		Columns: []pipeline.Column{
+			{Name: "order_id", Upstreams: []*pipeline.UpstreamColumn{{Table: "raw.orders", Column: "id"}}},
+			{Name: "revenue", Upstreams: []*pipeline.UpstreamColumn{{Table: "RAW.ORDERS", Column: "amount"}}},
+		},
+	}
+	report := &pipeline.Asset{
+		Name:      "mart.report",
+		Type:      pipeline.AssetTypeBigqueryQuery,
+		Upstreams: []pipeline.Upstream{{Type: "asset", Value: "mart.revenue"}},
+		Columns: []pipeline.Column{
+			{Name: "total", Upstreams: []*pipeline.UpstreamColumn{{Table: "mart.revenue", Column: "revenue"}}},
+		},
+	}
+
+	return &pipeline.Pipeline{Name: "test", Assets: []*pipeline.Asset{orders, revenue, report}}
+}
+
+func nodeNames(nodes []*GraphNode) []string {
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Asset
+		if node.Column != "" {
+			names[i] += "." + node.Column
+		}
+	}
+
+	return names
+}
+
+func TestNewAssetGraph(t *testing.T) {
+	t.Parallel()
+
+	p := graphTestPipeline()
+	g := NewAssetGraph(p.Assets[1:])
+
+	assert.Equal(t, []string{"mart.revenue", "mart.report"}, nodeNames(g.Nodes))
+	require.Len(t, g.Edges, 1)
+	assert.Equal(t, "mart.revenue", g.Edges[0].From.Asset)
+	assert.Equal(t, "mart.report", g.Edges[0].To.Asset)
+
+	assert.Equal(t, `flowchart LR
+  n0["mart.revenue"]
+  n1["mart.report"]
+  n0 --> n1
+`, g.Mermaid())
+
+	g = NewAssetGraph(p.Assets)
+	assert.Equal(t, `digraph lineage {
+  rankdir=LR;
+  node [shape=box];
+  n0 [label="raw.orders"];
+  n1 [label="mart.revenue"];
+  n2 [label="mart.report"];
+  n3 [label="bigquery://project/landing/orders", style=dashed];
+  n3 -> n0;
+  n0 -> n1;
+  n1 -> n2;
+}
+`, g.DOT())
+	assert.Equal(t, []string{"mart.revenue", "mart.report"}, nodeNames(g.Downstream(g.AssetNode("raw.orders"), true)))
+	assert.Equal(t, []string{"mart.revenue"}, nodeNames(g.Downstream(g.AssetNode("raw.orders"), false)))
+}
+
+func TestNewColumnGraph(t *testing.T) {
+	t.Parallel()
+
+	p := graphTestPipeline()
+	g := NewColumnGraph(p, p.Assets)
+
+	total := g.ColumnNode("mart.report", "total")
+	require.NotNil(t, total)
+	assert.Equal(t, []string{"mart.revenue.revenue"}, nodeNames(g.Upstream(total, false)))
+	assert.Equal(t, []string{"mart.revenue.revenue", "raw.orders.amount", "landing.orders.amount"}, nodeNames(g.Upstream(total, true)))
+	assert.Equal(t, []string{"mart.revenue.order_id"}, nodeNames(g.Downstream(g.ColumnNode("raw.orders", "id"), true)))
+
+	assert.Equal(t, `flowchart LR
+  subgraph g0["raw.orders"]
+    n0["id"]
+    n1["amount"]
+  end
+  subgraph g1["mart.revenue"]
+    n2["order_id"]
+    n3["revenue"]
+  end
+  subgraph g2["mart.report"]
+    n4["total"]
+  end
+  subgraph g3["landing.orders"]
+    n5["order_id"]:::external
+    n6["amount"]:::external
+  end
+  n5 --> n0
+  n6 --> n1
+  n0 --> n2
+  n1 --> n3
+  n3 --> n4
+  classDef external stroke-dasharray: 5 5
+`, g.Mermaid())
+
+	g = NewColumnGraph(p, p.Assets[1:])
+	assert.Equal(t, `digraph lineage {
+  rankdir=LR;
+  node [shape=box];
+  subgraph cluster_0 {
+    label="mart.revenue";
+    n0 [label="order_id"];
+    n1 [label="revenue"];
+  }
+  subgraph cluster_1 {
+    label="mart.report";
+    n2 [label="total"];
+  }
+  n1 -> n2;
+}
+`, g.DOT())
+}
+
+func TestGraph_HTML(t *testing.T) {
+	t.Parallel()
+
+	g := NewAssetGraph(graphTestPipeline().Assets[1:])
+	page := g.HTML("Lineage of <test>")
+
+	assert.Contains(t, page, "<title>Lineage of &lt;test&gt;</title>")
+	assert.Contains(t, page, "n0[&#34;mart.revenue&#34;]")
+	assert.Contains(t, page, "n0 --&gt; n1")
+}
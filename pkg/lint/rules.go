@@ -23,7 +23,6 @@ import (
 	"github.com/bruin-data/bruin/pkg/query"
 	"github.com/bruin-data/bruin/pkg/sqlparser"
 	"github.com/pkg/errors"
-	"github.com/robfig/cron/v3"
 	"github.com/spf13/afero"
 	"github.com/yourbasic/graph"
 )
@@ -367,17 +366,11 @@ func EnsurePipelineScheduleIsValidCron(ctx context.Context, p *pipeline.Pipeline
 		return issues, nil
 	}
 
-	if p.Schedule == "continuous" || p.Schedule == "@continuous" {
+	if p.Schedule.IsContinuous() {
 		return issues, nil
 	}
 
-	schedule := p.Schedule
-	if schedule == "daily" || schedule == "hourly" || schedule == "weekly" || schedule == "monthly" {
-		schedule = "@" + schedule
-	}
-
-	_, err := cron.ParseStandard(string(schedule))
-	if err != nil {
+	if _, err := p.Schedule.CronSchedule(); err != nil {
 		issues = append(issues, &Issue{
 			Description: fmt.Sprintf("Invalid cron schedule '%s'", p.Schedule),
 		})
@@ -504,6 +497,167 @@ func ValidateCustomCheckQueryExists(ctx context.Context, p *pipeline.Pipeline, a
 	return issues, nil
 }
 
+// ValidateTableChecks ensures the table checks of an asset are known and have the fields they need to run.
+func ValidateTableChecks(ctx context.Context, p *pipeline.Pipeline, asset *pipeline.Asset) ([]*Issue, error) {
+	var issues []*Issue
+	addIssue := func(format string, args ...any) {
+		issues = append(issues, &Issue{
+			Task:        asset,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	seen := make(map[string]bool, len(asset.TableChecks))
+	for _, check := range asset.TableChecks {
+		if seen[check.HumanName()] {
+			addIssue("Duplicate table check '%s' found", check.HumanName())
+		}
+		seen[check.HumanName()] = true
+
+		switch check.Name {
+		case pipeline.TableCheckRowCount:
+			if check.Min == nil && check.Max == nil {
+				addIssue("Table check 'row_count' requires at least one of 'min' or 'max'")
+			}
+			if check.Min != nil && check.Max != nil && *check.Min > *check.Max {
+				addIssue("Table check 'row_count' has a 'min' of %d that is greater than its 'max' of %d", *check.Min, *check.Max)
+			}
+		case pipeline.TableCheckFreshness:
+			if check.Column == "" {
+				addIssue("Table check 'freshness' requires a 'column'")
+			}
+			if _, err := helpers.ParseSensorDuration(check.MaxAge); err != nil {
+				addIssue("Table check 'freshness' requires a valid 'max_age' such as '6h' or '2d': %s", err)
+			}
+		case pipeline.TableCheckRowCountChange:
+			if check.MaxChange == nil || *check.MaxChange < 0 {
+				addIssue("Table check 'row_count_change' requires a non-negative 'max_change' percentage")
+			}
+		default:
+			addIssue("Unknown table check '%s', available checks are '%s', '%s' and '%s'",
+				check.Name, pipeline.TableCheckRowCount, pipeline.TableCheckFreshness, pipeline.TableCheckRowCountChange)
+		}
+	}
+
+	return issues, nil
+}
+
+// ValidateCheckSeverities ensures the severities and the `warn_if`/`error_if` thresholds of the checks of an asset are valid.
+func ValidateCheckSeverities(ctx context.Context, p *pipeline.Pipeline, asset *pipeline.Asset) ([]*Issue, error) {
+	var issues []*Issue
+	validate := func(checkName string, severity pipeline.CheckSeverity, warnIf, errorIf string) {
+		if !severity.IsValid() {
+			issues = append(issues, &Issue{
+				Task: asset,
+				Description: fmt.Sprintf("Check '%s' has an invalid severity '%s', it must be either '%s' or '%s'",
+					checkName, severity, pipeline.CheckSeverityError, pipeline.CheckSeverityWarn),
+			})
+		}
+
+		validateThreshold := func(field, threshold string) {
+			if threshold == "" {
+				return
+			}
+			if _, err := pipeline.ParseCheckThreshold(threshold); err != nil {
+				issues = append(issues, &Issue{
+					Task:        asset,
+					Description: fmt.Sprintf("Check '%s' has an invalid '%s': %s", checkName, field, err),
+				})
+			}
+		}
+		validateThreshold("warn_if", warnIf)
+		validateThreshold("error_if", errorIf)
+	}
+
+	for _, column := range asset.Columns {
+		for _, check := range column.Checks {
+			validate(column.Name+"."+check.Name, check.Severity, check.WarnIf, check.ErrorIf)
+		}
+	}
+	for _, check := range asset.CustomChecks {
+		validate(check.Name, check.Severity, check.WarnIf, check.ErrorIf)
+	}
+	for _, check := range asset.TableChecks {
+		validate(check.HumanName(), check.Severity, check.WarnIf, check.ErrorIf)
+	}
+
+	return issues, nil
+}
+
+// ValidateCheckScopes ensures the scopes of the checks of an asset are valid and that the checks scoped to the run
+// interval belong to an incremental asset.
+func ValidateCheckScopes(ctx context.Context, p *pipeline.Pipeline, asset *pipeline.Asset) ([]*Issue, error) {
+	var issues []*Issue
+	validate := func(checkName string, scope pipeline.CheckScope) {
+		if !scope.IsValid() {
+			issues = append(issues, &Issue{
+				Task: asset,
+				Description: fmt.Sprintf("Check '%s' has an invalid scope '%s', it must be either '%s' or '%s'",
+					checkName, scope, pipeline.CheckScopeTable, pipeline.CheckScopeInterval),
+			})
+			return
+		}
+
+		if scope != pipeline.CheckScopeInterval {
+			return
+		}
+		if err := asset.ValidateIntervalScope(); err != nil {
+			issues = append(issues, &Issue{
+				Task:        asset,
+				Description: fmt.Sprintf("Check '%s' cannot be scoped to the interval: %s", checkName, err),
+			})
+		}
+	}
+
+	for _, column := range asset.Columns {
+		for _, check := range column.Checks {
+			validate(column.Name+"."+check.Name, check.Scope)
+		}
+	}
+	for _, check := range asset.CustomChecks {
+		validate(check.Name, check.Scope)
+	}
+
+	return issues, nil
+}
+
+// ValidateUnitTests ensures the unit tests of an asset are well-formed, the fixture files are only read when the tests run.
+func ValidateUnitTests(ctx context.Context, p *pipeline.Pipeline, asset *pipeline.Asset) ([]*Issue, error) {
+	var issues []*Issue
+	if len(asset.Tests) == 0 {
+		return issues, nil
+	}
+
+	if !asset.IsSQLAsset() {
+		return append(issues, &Issue{
+			Task:        asset,
+			Description: fmt.Sprintf("Unit tests are only supported for SQL assets, '%s' assets cannot have tests", asset.Type),
+		}), nil
+	}
+
+	seen := make(map[string]bool, len(asset.Tests))
+	for i := range asset.Tests {
+		test := &asset.Tests[i]
+		if err := test.Validate(); err != nil {
+			issues = append(issues, &Issue{
+				Task:        asset,
+				Description: "Invalid unit test: " + err.Error(),
+			})
+			continue
+		}
+
+		if seen[test.Name] {
+			issues = append(issues, &Issue{
+				Task:        asset,
+				Description: fmt.Sprintf("Unit test '%s' is defined more than once", test.Name),
+			})
+		}
+		seen[test.Name] = true
+	}
+
+	return issues, nil
+}
+
 func ValidatePythonAssetMaterialization(ctx context.Context, p *pipeline.Pipeline, asset *pipeline.Asset) ([]*Issue, error) {
 	issues := make([]*Issue, 0)
 	if asset.Type != pipeline.AssetTypePython {
@@ -1759,6 +1913,14 @@ func ValidateVariables(ctx context.Context, p *pipeline.Pipeline) ([]*Issue, err
 		})
 	}
 
+	for _, name := range p.Variants.Names() {
+		if err := p.Variables.ValidateValues(p.Variants[name]); err != nil {
+			issues = append(issues, &Issue{
+				Description: fmt.Sprintf("invalid values in variant %q: %s", name, err),
+			})
+		}
+	}
+
 	return issues, nil
 }
 
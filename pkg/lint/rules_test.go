@@ -2774,6 +2774,257 @@ func TestValidateDuplicateTags(t *testing.T) {
 	}
 }
 
+func TestValidateTableChecks(t *testing.T) {
+	t.Parallel()
+
+	minRows, maxRows := int64(10), int64(5)
+	maxChange := 20.0
+
+	tests := []struct {
+		name   string
+		checks []pipeline.TableCheck
+		want   []string
+	}{
+		{
+			name: "valid checks",
+			checks: []pipeline.TableCheck{
+				{Name: pipeline.TableCheckRowCount, Min: &maxRows},
+				{Name: pipeline.TableCheckFreshness, Column: "created_at", MaxAge: "6h"},
+				{Name: pipeline.TableCheckFreshness, Column: "updated_at", MaxAge: "2d"},
+				{Name: pipeline.TableCheckRowCountChange, MaxChange: &maxChange},
+			},
+		},
+		{
+			name: "row count without bounds and inverted bounds",
+			checks: []pipeline.TableCheck{
+				{Name: pipeline.TableCheckRowCount},
+				{Name: pipeline.TableCheckRowCount, Min: &minRows, Max: &maxRows},
+			},
+			want: []string{
+				"Table check 'row_count' requires at least one of 'min' or 'max'",
+				"Duplicate table check 'row_count' found",
+				"Table check 'row_count' has a 'min' of 10 that is greater than its 'max' of 5",
+			},
+		},
+		{
+			name:   "freshness without a column and with an invalid max age",
+			checks: []pipeline.TableCheck{{Name: pipeline.TableCheckFreshness, MaxAge: "soon"}},
+			want: []string{
+				"Table check 'freshness' requires a 'column'",
+				"Table check 'freshness' requires a valid 'max_age' such as '6h' or '2d': invalid numeric portion in \"soon\"",
+			},
+		},
+		{
+			name:   "row count change without a limit",
+			checks: []pipeline.TableCheck{{Name: pipeline.TableCheckRowCountChange}},
+			want:   []string{"Table check 'row_count_change' requires a non-negative 'max_change' percentage"},
+		},
+		{
+			name:   "unknown check",
+			checks: []pipeline.TableCheck{{Name: "volume"}},
+			want:   []string{"Unknown table check 'volume', available checks are 'row_count', 'freshness' and 'row_count_change'"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			issues, err := ValidateTableChecks(t.Context(), &pipeline.Pipeline{}, &pipeline.Asset{Name: "asset1", TableChecks: tt.checks})
+			require.NoError(t, err)
+
+			descriptions := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				descriptions = append(descriptions, issue.Description)
+			}
+			assert.ElementsMatch(t, tt.want, descriptions)
+		})
+	}
+}
+
+func TestValidateCheckSeverities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		asset *pipeline.Asset
+		want  []string
+	}{
+		{
+			name: "valid severities and thresholds",
+			asset: &pipeline.Asset{
+				Columns: []pipeline.Column{{
+					Name: "id",
+					Checks: []pipeline.ColumnCheck{
+						{Name: "not_null", Severity: pipeline.CheckSeverityWarn},
+						{Name: "unique", WarnIf: ">0", ErrorIf: ">=10"},
+					},
+				}},
+				CustomChecks: []pipeline.CustomCheck{{Name: "late orders", Severity: pipeline.CheckSeverityError}},
+				TableChecks:  []pipeline.TableCheck{{Name: pipeline.TableCheckRowCount, Severity: pipeline.CheckSeverityWarn, WarnIf: "<100"}},
+			},
+		},
+		{
+			name: "invalid severity and thresholds",
+			asset: &pipeline.Asset{
+				Columns: []pipeline.Column{{
+					Name:   "id",
+					Checks: []pipeline.ColumnCheck{{Name: "not_null", Severity: "fatal", ErrorIf: "10"}},
+				}},
+				CustomChecks: []pipeline.CustomCheck{{Name: "late orders", WarnIf: "> a few"}},
+				TableChecks: []pipeline.TableCheck{
+					{Name: pipeline.TableCheckFreshness, Column: "updated_at", Severity: "info"},
+					{Name: pipeline.TableCheckRowCount, ErrorIf: "lots"},
+				},
+			},
+			want: []string{
+				"Check 'id.not_null' has an invalid severity 'fatal', it must be either 'error' or 'warn'",
+				"Check 'id.not_null' has an invalid 'error_if': invalid threshold '10', it must be a comparison such as '>10' or '!=0'",
+				"Check 'late orders' has an invalid 'warn_if': invalid threshold '> a few', it must be a comparison such as '>10' or '!=0'",
+				"Check 'freshness:updated_at' has an invalid severity 'info', it must be either 'error' or 'warn'",
+				"Check 'row_count' has an invalid 'error_if': invalid threshold 'lots', it must be a comparison such as '>10' or '!=0'",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			issues, err := ValidateCheckSeverities(t.Context(), &pipeline.Pipeline{}, tt.asset)
+			require.NoError(t, err)
+
+			descriptions := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				descriptions = append(descriptions, issue.Description)
+			}
+			assert.ElementsMatch(t, tt.want, descriptions)
+		})
+	}
+}
+
+func TestValidateCheckScopes(t *testing.T) {
+	t.Parallel()
+
+	incremental := pipeline.Materialization{
+		Type:           pipeline.MaterializationTypeTable,
+		Strategy:       pipeline.MaterializationStrategyTimeInterval,
+		IncrementalKey: "dt",
+	}
+
+	tests := []struct {
+		name  string
+		asset *pipeline.Asset
+		want  []string
+	}{
+		{
+			name: "interval scope on an incremental asset",
+			asset: &pipeline.Asset{
+				Materialization: incremental,
+				Columns: []pipeline.Column{{
+					Name:   "id",
+					Checks: []pipeline.ColumnCheck{{Name: "unique", Scope: pipeline.CheckScopeInterval}, {Name: "not_null", Scope: pipeline.CheckScopeTable}},
+				}},
+				CustomChecks: []pipeline.CustomCheck{{Name: "late orders", Scope: pipeline.CheckScopeInterval}},
+			},
+		},
+		{
+			name: "invalid scope",
+			asset: &pipeline.Asset{
+				Materialization: incremental,
+				Columns:         []pipeline.Column{{Name: "id", Checks: []pipeline.ColumnCheck{{Name: "unique", Scope: "partition"}}}},
+			},
+			want: []string{"Check 'id.unique' has an invalid scope 'partition', it must be either 'table' or 'interval'"},
+		},
+		{
+			name: "interval scope on a table that is not incremental",
+			asset: &pipeline.Asset{
+				Materialization: pipeline.Materialization{Type: pipeline.MaterializationTypeTable},
+				CustomChecks:    []pipeline.CustomCheck{{Name: "late orders", Scope: pipeline.CheckScopeInterval}},
+			},
+			want: []string{"Check 'late orders' cannot be scoped to the interval: checks with the 'interval' scope require the asset to use the 'time_interval' or 'delete+insert' materialization strategy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			issues, err := ValidateCheckScopes(t.Context(), &pipeline.Pipeline{}, tt.asset)
+			require.NoError(t, err)
+
+			descriptions := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				descriptions = append(descriptions, issue.Description)
+			}
+			assert.ElementsMatch(t, tt.want, descriptions)
+		})
+	}
+}
+
+func TestValidateUnitTests(t *testing.T) {
+	t.Parallel()
+
+	orders := pipeline.UnitTestFixture{Table: "raw.orders", Rows: []map[string]any{{"id": 1}}}
+
+	tests := []struct {
+		name  string
+		asset *pipeline.Asset
+		want  []string
+	}{
+		{
+			name: "valid tests",
+			asset: &pipeline.Asset{
+				Type: pipeline.AssetTypeBigqueryQuery,
+				Tests: []pipeline.UnitTest{
+					{Name: "inline", Given: []pipeline.UnitTestFixture{orders}, Expect: pipeline.UnitTestRows{Rows: []map[string]any{{"id": 1}}}},
+					{Name: "from csv", Given: []pipeline.UnitTestFixture{{Table: "raw.orders", CSV: "fixtures/orders.csv"}}},
+				},
+			},
+		},
+		{
+			name: "tests on a non-SQL asset",
+			asset: &pipeline.Asset{
+				Type:  pipeline.AssetTypePython,
+				Tests: []pipeline.UnitTest{{Name: "inline", Given: []pipeline.UnitTestFixture{orders}}},
+			},
+			want: []string{"Unit tests are only supported for SQL assets, 'python' assets cannot have tests"},
+		},
+		{
+			name: "invalid and duplicate tests",
+			asset: &pipeline.Asset{
+				Type: pipeline.AssetTypeSnowflakeQuery,
+				Tests: []pipeline.UnitTest{
+					{Name: "inline", Given: []pipeline.UnitTestFixture{orders}},
+					{Name: "inline", Given: []pipeline.UnitTestFixture{orders}},
+					{Name: "no rows", Given: []pipeline.UnitTestFixture{{Table: "raw.orders"}}},
+					{Name: "no fixtures"},
+				},
+			},
+			want: []string{
+				"Unit test 'inline' is defined more than once",
+				"Invalid unit test: unit test 'no rows' must give the rows or a csv file for table 'raw.orders'",
+				"Invalid unit test: unit test 'no fixtures' must give the rows of at least one upstream table",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			issues, err := ValidateUnitTests(t.Context(), &pipeline.Pipeline{}, tt.asset)
+			require.NoError(t, err)
+
+			descriptions := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				descriptions = append(descriptions, issue.Description)
+			}
+			assert.ElementsMatch(t, tt.want, descriptions)
+		})
+	}
+}
+
 func TestEnsureValidPythonAssetMaterialization(t *testing.T) {
 	t.Parallel()
 
@@ -3245,6 +3496,36 @@ func TestValidateHookQueryDryRun(t *testing.T) {
 	})
 }
 
+func TestValidateVariables(t *testing.T) {
+	t.Parallel()
+
+	newPipeline := func() *pipeline.Pipeline {
+		return &pipeline.Pipeline{
+			Variables: pipeline.Variables{
+				"env":   {"type": "string", "enum": []any{"dev", "prod"}, "default": "dev"},
+				"limit": {"type": "integer", "default": 10},
+			},
+			Variants: pipeline.VariantSet{
+				"prod":   {"env": "prod", "limit": 100},
+				"broken": {"limit": "a lot"},
+			},
+		}
+	}
+
+	issues, err := ValidateVariables(t.Context(), newPipeline())
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, `invalid values in variant "broken": variable "limit": Invalid type. Expected: integer, given: string`, issues[0].Description)
+
+	p := newPipeline()
+	p.Variables["env"]["default"] = "staging"
+	delete(p.Variants, "broken")
+	issues, err = ValidateVariables(t.Context(), p)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Contains(t, issues[0].Description, `invalid variable defaults: variable "env"`)
+}
+
 func TestEnsurePipelineConcurrencyIsValid(t *testing.T) {
 	t.Parallel()
 
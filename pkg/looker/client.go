@@ -0,0 +1,201 @@
+package looker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const apiVersion = "4.0"
+
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// Datagroup is a Looker caching policy, resetting it invalidates the cached query results of the explores using it.
+type Datagroup struct {
+	ID          string `json:"id"`
+	ModelName   string `json:"model_name"`
+	Name        string `json:"name"`
+	StaleBefore int64  `json:"stale_before,omitempty"`
+	TriggeredAt int64  `json:"triggered_at,omitempty"`
+}
+
+// datagroupReset is the body of the datagroup update, it only carries the fields being changed so that the read-only
+// ones such as the ID and the name are not sent back.
+type datagroupReset struct {
+	StaleBefore int64 `json:"stale_before"`
+	TriggeredAt int64 `json:"triggered_at"`
+}
+
+type loginResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func NewClient(c Config) (*Client, error) {
+	if c.BaseURL == "" {
+		return nil, errors.New("base_url is required for Looker connection")
+	}
+	if c.ClientID == "" || c.ClientSecret == "" {
+		return nil, errors.New("client_id and client_secret are required for Looker connection")
+	}
+
+	c.BaseURL = strings.TrimRight(c.BaseURL, "/")
+
+	return &Client{
+		config: c,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+func (c *Client) apiURL(path string) string {
+	return fmt.Sprintf("%s/api/%s/%s", c.config.BaseURL, apiVersion, path)
+}
+
+// login returns the API access token, logging in again a minute before the current one expires.
+func (c *Client) login(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != "" && time.Now().Before(c.tokenExpiry.Add(-time.Minute)) {
+		return c.accessToken, nil
+	}
+
+	form := url.Values{
+		"client_id":     {c.config.ClientID},
+		"client_secret": {c.config.ClientSecret},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL("login"), strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create authentication request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to perform authentication request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.Errorf("authentication failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var login loginResponse
+	if err := json.NewDecoder(resp.Body).Decode(&login); err != nil {
+		return "", errors.Wrap(err, "failed to decode authentication response")
+	}
+	if login.AccessToken == "" {
+		return "", errors.New("no access token received from Looker")
+	}
+
+	c.accessToken = login.AccessToken
+	c.tokenExpiry = time.Now().Add(time.Duration(login.ExpiresIn) * time.Second)
+	return c.accessToken, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, payload, out any) error {
+	token, err := c.login(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to authenticate with Looker")
+	}
+
+	var body io.Reader
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request payload")
+		}
+		body = bytes.NewReader(payloadBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL(path), body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request for '%s'", path)
+	}
+	req.Header.Set("Authorization", "token "+token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to perform request for '%s'", path)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("request for '%s' failed with status %d: %s", path, resp.StatusCode, string(respBody))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Wrapf(err, "failed to decode the response for '%s'", path)
+	}
+
+	return nil
+}
+
+func (c *Client) ListDatagroups(ctx context.Context) ([]Datagroup, error) {
+	var datagroups []Datagroup
+	if err := c.do(ctx, http.MethodGet, "datagroups", nil, &datagroups); err != nil {
+		return nil, err
+	}
+
+	return datagroups, nil
+}
+
+// ResetDatagroup marks the cache of the datagroup stale and triggers it, so that the dashboards using it run their
+// queries against the fresh data and the schedules waiting on the datagroup are sent.
+func (c *Client) ResetDatagroup(ctx context.Context, datagroupID string, at time.Time) error {
+	payload := datagroupReset{
+		StaleBefore: at.Unix(),
+		TriggeredAt: at.Unix(),
+	}
+
+	return c.do(ctx, http.MethodPatch, "datagroups/"+url.PathEscape(datagroupID), payload, nil)
+}
+
+// FindDatagroupID returns the ID of the datagroup with the given name, the model name is only needed when more than
+// one model defines a datagroup with that name.
+func FindDatagroupID(datagroups []Datagroup, modelName, name string) (string, error) {
+	var matches []Datagroup
+	for _, dg := range datagroups {
+		if dg.Name != name || (modelName != "" && dg.ModelName != modelName) {
+			continue
+		}
+		matches = append(matches, dg)
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", errors.Errorf("no datagroup found with name '%s'", name)
+	case 1:
+		return matches[0].ID, nil
+	default:
+		return "", errors.Errorf("multiple datagroups found with name '%s', set the 'model_name' parameter to pick one", name)
+	}
+}
@@ -0,0 +1,149 @@
+package looker
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/4.0/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.FormValue("client_id") != "id" || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token": "token123", "expires_in": 3600}`))
+	})
+	mux.HandleFunc("/api/4.0/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token token123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewClient_MissingRequiredFields(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(Config{ClientID: "id", ClientSecret: "secret"})
+	require.EqualError(t, err, "base_url is required for Looker connection")
+
+	_, err = NewClient(Config{BaseURL: "https://example.looker.com", ClientID: "id"})
+	require.EqualError(t, err, "client_id and client_secret are required for Looker connection")
+}
+
+func TestClient_ResetDatagroup(t *testing.T) {
+	t.Parallel()
+
+	var got map[string]any
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/4.0/datagroups/12", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"id": "12"}`))
+	})
+
+	client, err := NewClient(Config{BaseURL: server.URL + "/", ClientID: "id", ClientSecret: "secret"})
+	require.NoError(t, err)
+
+	at := time.Unix(1717200000, 0)
+	require.NoError(t, client.ResetDatagroup(t.Context(), "12", at))
+	assert.Equal(t, map[string]any{"stale_before": float64(1717200000), "triggered_at": float64(1717200000)}, got)
+}
+
+func TestClient_ErrorResponses(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not found"}`))
+	})
+
+	client, err := NewClient(Config{BaseURL: server.URL, ClientID: "id", ClientSecret: "secret"})
+	require.NoError(t, err)
+	require.EqualError(t, client.ResetDatagroup(t.Context(), "12", time.Now()), `request for 'datagroups/12' failed with status 404: {"message": "Not found"}`)
+
+	client, err = NewClient(Config{BaseURL: server.URL, ClientID: "id", ClientSecret: "wrong"})
+	require.NoError(t, err)
+	_, err = client.ListDatagroups(t.Context())
+	require.EqualError(t, err, "failed to authenticate with Looker: authentication failed with status 401: ")
+}
+
+func TestClient_TokenRefresh(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expiresIn  int
+		wantLogins int
+	}{
+		{name: "valid token is reused", expiresIn: 3600, wantLogins: 1},
+		{name: "token about to expire is refreshed", expiresIn: 30, wantLogins: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logins := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/4.0/login", func(w http.ResponseWriter, r *http.Request) {
+				logins++
+				_, _ = fmt.Fprintf(w, `{"access_token": "token%d", "expires_in": %d}`, logins, tt.expiresIn)
+			})
+			mux.HandleFunc("/api/4.0/datagroups", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, fmt.Sprintf("token token%d", logins), r.Header.Get("Authorization"))
+				_, _ = w.Write([]byte(`[]`))
+			})
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			client, err := NewClient(Config{BaseURL: server.URL, ClientID: "id", ClientSecret: "secret"})
+			require.NoError(t, err)
+
+			for range 2 {
+				_, err = client.ListDatagroups(t.Context())
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantLogins, logins)
+		})
+	}
+}
+
+func TestFindDatagroupID(t *testing.T) {
+	t.Parallel()
+
+	datagroups := []Datagroup{
+		{ID: "1", ModelName: "sales", Name: "daily"},
+		{ID: "2", ModelName: "marketing", Name: "daily"},
+		{ID: "3", ModelName: "sales", Name: "hourly"},
+	}
+
+	id, err := FindDatagroupID(datagroups, "", "hourly")
+	require.NoError(t, err)
+	assert.Equal(t, "3", id)
+
+	id, err = FindDatagroupID(datagroups, "marketing", "daily")
+	require.NoError(t, err)
+	assert.Equal(t, "2", id)
+
+	_, err = FindDatagroupID(datagroups, "", "daily")
+	require.EqualError(t, err, "multiple datagroups found with name 'daily', set the 'model_name' parameter to pick one")
+
+	_, err = FindDatagroupID(datagroups, "", "weekly")
+	require.EqualError(t, err, "no datagroup found with name 'weekly'")
+}
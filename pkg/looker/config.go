@@ -0,0 +1,12 @@
+package looker
+
+type Config struct {
+	Name         string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	BaseURL      string `yaml:"base_url,omitempty" json:"base_url" mapstructure:"base_url"`
+	ClientID     string `yaml:"client_id,omitempty" json:"client_id" mapstructure:"client_id"`
+	ClientSecret string `yaml:"client_secret,omitempty" json:"client_secret" mapstructure:"client_secret"`
+}
+
+func (c Config) GetName() string {
+	return c.Name
+}
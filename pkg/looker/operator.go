@@ -0,0 +1,85 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/bruin-data/bruin/pkg/executor"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/pkg/errors"
+)
+
+type BasicOperator struct {
+	connection config.ConnectionGetter
+}
+
+func NewBasicOperator(conn config.ConnectionGetter) *BasicOperator {
+	return &BasicOperator{
+		connection: conn,
+	}
+}
+
+func (o BasicOperator) Run(ctx context.Context, ti scheduler.TaskInstance) error {
+	return o.RunTask(ctx, ti.GetPipeline(), ti.GetAsset())
+}
+
+func (o BasicOperator) RunTask(ctx context.Context, p *pipeline.Pipeline, t *pipeline.Asset) error {
+	// without the refresh parameter the asset only marks the dashboard in the lineage
+	if t.Parameters["refresh"] != "true" {
+		return nil
+	}
+
+	connName, err := p.GetConnectionNameForAsset(t)
+	if err != nil {
+		return errors.Wrap(err, "failed to get connection name for asset")
+	}
+
+	rawConn := o.connection.GetConnection(connName)
+	if rawConn == nil {
+		return config.NewConnectionNotFoundError(ctx, "", connName)
+	}
+
+	client, ok := rawConn.(*Client)
+	if !ok {
+		return errors.Errorf("connection '%s' is not a looker connection", connName)
+	}
+
+	datagroupID := t.Parameters["datagroup_id"]
+	if datagroupID == "" {
+		name := t.Parameters["datagroup_name"]
+		if name == "" {
+			return errors.New("looker asset requires either 'datagroup_id' or 'datagroup_name' parameter when 'refresh' is true")
+		}
+
+		datagroups, err := client.ListDatagroups(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to list datagroups for name lookup")
+		}
+
+		datagroupID, err = FindDatagroupID(datagroups, t.Parameters["model_name"], name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := client.ResetDatagroup(ctx, datagroupID, time.Now()); err != nil {
+		return errors.Wrapf(err, "failed to reset Looker datagroup '%s'", datagroupID)
+	}
+
+	fmt.Fprintf(writerFromContext(ctx), "Reset Looker datagroup '%s', the dashboards using it will query the fresh data.\n", datagroupID)
+	return nil
+}
+
+func writerFromContext(ctx context.Context) io.Writer {
+	if w := ctx.Value(executor.KeyPrinter); w != nil {
+		if wr, ok := w.(io.Writer); ok {
+			return wr
+		}
+	}
+	return os.Stdout
+}
@@ -0,0 +1,72 @@
+package looker
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/executor"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type connections map[string]any
+
+func (c connections) GetConnection(name string) any {
+	return c[name]
+}
+
+func TestBasicOperator_RunTask(t *testing.T) {
+	t.Parallel()
+
+	var resets []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/4.0/datagroups":
+			_, _ = w.Write([]byte(`[{"id": "1", "model_name": "sales", "name": "daily"}, {"id": "2", "model_name": "sales", "name": "hourly"}]`))
+		case r.Method == http.MethodPatch:
+			resets = append(resets, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client, err := NewClient(Config{BaseURL: server.URL, ClientID: "id", ClientSecret: "secret"})
+	require.NoError(t, err)
+
+	op := NewBasicOperator(connections{"looker-default": client})
+	p := &pipeline.Pipeline{DefaultConnections: map[string]string{"looker": "looker-default"}}
+	ctx := context.WithValue(t.Context(), executor.KeyPrinter, &bytes.Buffer{})
+
+	// the lineage-only assets need neither the parameters nor the connection
+	require.NoError(t, NewBasicOperator(connections{}).RunTask(ctx, p, &pipeline.Asset{Name: "dash", Type: pipeline.AssetTypeLooker}))
+
+	require.NoError(t, op.RunTask(ctx, p, &pipeline.Asset{
+		Name:       "by_id",
+		Type:       pipeline.AssetTypeLooker,
+		Parameters: map[string]string{"refresh": "true", "datagroup_id": "7"},
+	}))
+	require.NoError(t, op.RunTask(ctx, p, &pipeline.Asset{
+		Name:       "by_name",
+		Type:       pipeline.AssetTypeLooker,
+		Parameters: map[string]string{"refresh": "true", "datagroup_name": "hourly"},
+	}))
+	assert.Equal(t, []string{"/api/4.0/datagroups/7", "/api/4.0/datagroups/2"}, resets)
+
+	err = op.RunTask(ctx, p, &pipeline.Asset{
+		Name:       "no_datagroup",
+		Type:       pipeline.AssetTypeLooker,
+		Parameters: map[string]string{"refresh": "true"},
+	})
+	require.EqualError(t, err, "looker asset requires either 'datagroup_id' or 'datagroup_name' parameter when 'refresh' is true")
+
+	err = NewBasicOperator(connections{"looker-default": "not a client"}).RunTask(ctx, p, &pipeline.Asset{
+		Name:       "wrong_connection",
+		Type:       pipeline.AssetTypeLooker,
+		Parameters: map[string]string{"refresh": "true", "datagroup_id": "7"},
+	})
+	require.EqualError(t, err, "connection 'looker-default' is not a looker connection")
+}
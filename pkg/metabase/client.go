@@ -0,0 +1,170 @@
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	sessionID string
+}
+
+// CacheTargets are the objects whose cached query results are invalidated, at least one of them must be set.
+type CacheTargets struct {
+	DashboardIDs []string
+	QuestionIDs  []string
+	DatabaseIDs  []string
+}
+
+func (t CacheTargets) IsEmpty() bool {
+	return len(t.DashboardIDs) == 0 && len(t.QuestionIDs) == 0 && len(t.DatabaseIDs) == 0
+}
+
+type invalidateResponse struct {
+	Count   int    `json:"count"`
+	Message string `json:"message"`
+}
+
+func NewClient(c Config) (*Client, error) {
+	if c.BaseURL == "" {
+		return nil, errors.New("base_url is required for Metabase connection")
+	}
+	if c.APIKey == "" && (c.Username == "" || c.Password == "") {
+		return nil, errors.New("either api_key or username and password are required for Metabase connection")
+	}
+
+	c.BaseURL = strings.TrimRight(c.BaseURL, "/")
+
+	return &Client{
+		config: c,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+// session logs in with the username and password, the session is reused for the lifetime of the client.
+func (c *Client) session(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sessionID != "" {
+		return c.sessionID, nil
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"username": c.config.Username,
+		"password": c.config.Password,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal authentication request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.BaseURL+"/api/session", bytes.NewReader(payload))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create authentication request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to perform authentication request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.Errorf("authentication failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var session struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+		return "", errors.Wrap(err, "failed to decode authentication response")
+	}
+	if session.ID == "" {
+		return "", errors.New("no session ID received from Metabase")
+	}
+
+	c.sessionID = session.ID
+	return c.sessionID, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.config.BaseURL+path, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create request for '%s'", path)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if c.config.APIKey != "" {
+		req.Header.Set("X-Api-Key", c.config.APIKey)
+	} else {
+		sessionID, err := c.session(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to authenticate with Metabase")
+		}
+		req.Header.Set("X-Metabase-Session", sessionID)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to perform request for '%s'", path)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("request for '%s' failed with status %d: %s", path, resp.StatusCode, string(respBody))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Wrapf(err, "failed to decode the response for '%s'", path)
+	}
+
+	return nil
+}
+
+// InvalidateCache clears the cached results of the given dashboards, questions and databases, including the caching
+// policies overridden on the objects under them, and returns the number of invalidated caches.
+func (c *Client) InvalidateCache(ctx context.Context, targets CacheTargets) (int, error) {
+	if targets.IsEmpty() {
+		return 0, errors.New("no dashboard, question or database given to invalidate the cache of")
+	}
+
+	query := url.Values{"include": {"overrides"}}
+	for _, id := range targets.DashboardIDs {
+		query.Add("dashboard", id)
+	}
+	for _, id := range targets.QuestionIDs {
+		query.Add("question", id)
+	}
+	for _, id := range targets.DatabaseIDs {
+		query.Add("database", id)
+	}
+
+	var resp invalidateResponse
+	if err := c.do(ctx, http.MethodPost, "/api/cache/invalidate?"+query.Encode(), &resp); err != nil {
+		return 0, err
+	}
+
+	return resp.Count, nil
+}
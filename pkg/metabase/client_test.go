@@ -0,0 +1,101 @@
+package metabase
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/session", func(w http.ResponseWriter, r *http.Request) {
+		var login map[string]string
+		if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&login) != nil ||
+			login["username"] != "user" || login["password"] != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "session123"}`))
+	})
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "key123" && r.Header.Get("X-Metabase-Session") != "session123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewClient_MissingRequiredFields(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(Config{APIKey: "key"})
+	require.EqualError(t, err, "base_url is required for Metabase connection")
+
+	_, err = NewClient(Config{BaseURL: "https://metabase.example.com", Username: "user"})
+	require.EqualError(t, err, "either api_key or username and password are required for Metabase connection")
+}
+
+func TestClient_InvalidateCache(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/cache/invalidate", r.URL.Path)
+		assert.Equal(t, []string{"1", "2"}, r.URL.Query()["dashboard"])
+		assert.Equal(t, []string{"3"}, r.URL.Query()["question"])
+		assert.Equal(t, "overrides", r.URL.Query().Get("include"))
+		_, _ = w.Write([]byte(`{"count": 4, "message": "Invalidated 4 cache configs"}`))
+	})
+
+	configs := map[string]Config{
+		"api key":  {BaseURL: server.URL + "/", APIKey: "key123"},
+		"password": {BaseURL: server.URL, Username: "user", Password: "pass"},
+	}
+	for name, c := range configs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient(c)
+			require.NoError(t, err)
+
+			count, err := client.InvalidateCache(t.Context(), CacheTargets{DashboardIDs: []string{"1", "2"}, QuestionIDs: []string{"3"}})
+			require.NoError(t, err)
+			assert.Equal(t, 4, count)
+		})
+	}
+}
+
+func TestClient_InvalidateCache_Errors(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`You don't have permissions to do that.`))
+	})
+
+	client, err := NewClient(Config{BaseURL: server.URL, APIKey: "key123"})
+	require.NoError(t, err)
+
+	_, err = client.InvalidateCache(t.Context(), CacheTargets{})
+	require.EqualError(t, err, "no dashboard, question or database given to invalidate the cache of")
+
+	_, err = client.InvalidateCache(t.Context(), CacheTargets{DatabaseIDs: []string{"5"}})
+	require.EqualError(t, err, "request for '/api/cache/invalidate?database=5&include=overrides' failed with status 403: You don't have permissions to do that.")
+
+	client, err = NewClient(Config{BaseURL: server.URL, Username: "user", Password: "wrong"})
+	require.NoError(t, err)
+
+	_, err = client.InvalidateCache(t.Context(), CacheTargets{DatabaseIDs: []string{"5"}})
+	require.ErrorContains(t, err, "failed to authenticate with Metabase: authentication failed with status 401")
+}
@@ -0,0 +1,13 @@
+package metabase
+
+type Config struct {
+	Name     string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	BaseURL  string `yaml:"base_url,omitempty" json:"base_url" mapstructure:"base_url"`
+	APIKey   string `yaml:"api_key,omitempty" json:"api_key,omitempty" mapstructure:"api_key"`
+	Username string `yaml:"username,omitempty" json:"username,omitempty" mapstructure:"username"`
+	Password string `yaml:"password,omitempty" json:"password,omitempty" mapstructure:"password"`
+}
+
+func (c Config) GetName() string {
+	return c.Name
+}
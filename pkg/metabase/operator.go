@@ -0,0 +1,89 @@
+package metabase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/bruin-data/bruin/pkg/executor"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/pkg/errors"
+)
+
+type BasicOperator struct {
+	connection config.ConnectionGetter
+}
+
+func NewBasicOperator(conn config.ConnectionGetter) *BasicOperator {
+	return &BasicOperator{
+		connection: conn,
+	}
+}
+
+func (o BasicOperator) Run(ctx context.Context, ti scheduler.TaskInstance) error {
+	return o.RunTask(ctx, ti.GetPipeline(), ti.GetAsset())
+}
+
+func (o BasicOperator) RunTask(ctx context.Context, p *pipeline.Pipeline, t *pipeline.Asset) error {
+	// without the refresh parameter the asset only marks the dashboard in the lineage
+	if t.Parameters["refresh"] != "true" {
+		return nil
+	}
+
+	targets := CacheTargets{
+		DashboardIDs: splitIDs(t.Parameters["dashboard_id"]),
+		QuestionIDs:  splitIDs(t.Parameters["question_id"]),
+		DatabaseIDs:  splitIDs(t.Parameters["database_id"]),
+	}
+	if targets.IsEmpty() {
+		return errors.New("metabase asset requires at least one of the 'dashboard_id', 'question_id' or 'database_id' parameters when 'refresh' is true")
+	}
+
+	connName, err := p.GetConnectionNameForAsset(t)
+	if err != nil {
+		return errors.Wrap(err, "failed to get connection name for asset")
+	}
+
+	rawConn := o.connection.GetConnection(connName)
+	if rawConn == nil {
+		return config.NewConnectionNotFoundError(ctx, "", connName)
+	}
+
+	client, ok := rawConn.(*Client)
+	if !ok {
+		return errors.Errorf("connection '%s' is not a metabase connection", connName)
+	}
+
+	count, err := client.InvalidateCache(ctx, targets)
+	if err != nil {
+		return errors.Wrap(err, "failed to invalidate the Metabase cache")
+	}
+
+	fmt.Fprintf(writerFromContext(ctx), "Invalidated %d Metabase caches, the next views will query the fresh data.\n", count)
+	return nil
+}
+
+// splitIDs reads a parameter holding a single ID or a comma-separated list of them.
+func splitIDs(value string) []string {
+	var ids []string
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func writerFromContext(ctx context.Context) io.Writer {
+	if w := ctx.Value(executor.KeyPrinter); w != nil {
+		if wr, ok := w.(io.Writer); ok {
+			return wr
+		}
+	}
+	return os.Stdout
+}
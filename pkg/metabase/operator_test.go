@@ -0,0 +1,62 @@
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/executor"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type connections map[string]any
+
+func (c connections) GetConnection(name string) any {
+	return c[name]
+}
+
+func TestBasicOperator_RunTask(t *testing.T) {
+	t.Parallel()
+
+	var queries []string
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		_, _ = w.Write([]byte(`{"count": 2}`))
+	})
+
+	client, err := NewClient(Config{BaseURL: server.URL, APIKey: "key123"})
+	require.NoError(t, err)
+
+	op := NewBasicOperator(connections{"metabase-default": client})
+	p := &pipeline.Pipeline{DefaultConnections: map[string]string{"metabase": "metabase-default"}}
+	out := &bytes.Buffer{}
+	ctx := context.WithValue(t.Context(), executor.KeyPrinter, out)
+
+	// the lineage-only assets need neither the parameters nor the connection
+	require.NoError(t, NewBasicOperator(connections{}).RunTask(ctx, p, &pipeline.Asset{Name: "dash", Type: pipeline.AssetTypeMetabase}))
+
+	require.NoError(t, op.RunTask(ctx, p, &pipeline.Asset{
+		Name:       "sales",
+		Type:       pipeline.AssetTypeMetabase,
+		Parameters: map[string]string{"refresh": "true", "dashboard_id": "1, 2", "question_id": "7"},
+	}))
+	assert.Equal(t, []string{"dashboard=1&dashboard=2&include=overrides&question=7"}, queries)
+	assert.Equal(t, "Invalidated 2 Metabase caches, the next views will query the fresh data.\n", out.String())
+
+	err = op.RunTask(ctx, p, &pipeline.Asset{
+		Name:       "no_targets",
+		Type:       pipeline.AssetTypeMetabase,
+		Parameters: map[string]string{"refresh": "true", "dashboard_id": " "},
+	})
+	require.EqualError(t, err, "metabase asset requires at least one of the 'dashboard_id', 'question_id' or 'database_id' parameters when 'refresh' is true")
+
+	err = NewBasicOperator(connections{"metabase-default": "not a client"}).RunTask(ctx, p, &pipeline.Asset{
+		Name:       "wrong_connection",
+		Type:       pipeline.AssetTypeMetabase,
+		Parameters: map[string]string{"refresh": "true", "database_id": "3"},
+	})
+	require.EqualError(t, err, "connection 'metabase-default' is not a metabase connection")
+}
@@ -80,7 +80,8 @@ func NewUniqueCheck(conn config.ConnectionGetter) *UniqueCheck {
 
 func (c *UniqueCheck) Check(ctx context.Context, ti *scheduler.ColumnCheckInstance) error {
 	qq := fmt.Sprintf("SELECT COUNT_BIG(%s) - COUNT_BIG(DISTINCT %s) FROM %s", ti.Column.Name, ti.Column.Name, ti.GetAsset().Name)
+	duplicates := ansisql.DuplicateValuesQuery(ti.GetAsset().Name, ti.Column.Name, "COUNT_BIG")
 	return ansisql.NewCountableQueryCheck(c.conn, 0, &query.Query{Query: qq}, "unique", func(count int64) error {
 		return errors.Errorf("column '%s' has %d non-unique values", ti.Column.Name, count)
-	}).Check(ctx, ti)
+	}).WithFailingRowsQuery(duplicates).Check(ctx, ti)
 }
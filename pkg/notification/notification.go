@@ -0,0 +1,318 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+)
+
+const (
+	defaultSlackAPIURL     = "https://slack.com/api/chat.postMessage"
+	defaultSlackConnection = "slack-default"
+	defaultTimeout         = 15 * time.Second
+
+	// Discord rejects messages longer than 2000 characters.
+	discordMessageLimit = 2000
+)
+
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	StatusFailure Status = "failure"
+)
+
+// Event describes a single notification about a pipeline run, or about one of its assets or checks.
+type Event struct {
+	Pipeline string
+	Asset    string
+	Column   string
+	Check    string
+	RunID    string
+	Status   Status
+	Summary  string
+	Error    string
+}
+
+// Message renders the event as a human-readable text message.
+func (e *Event) Message() string {
+	var b strings.Builder
+
+	subject := fmt.Sprintf("Pipeline `%s`", e.Pipeline)
+	if e.Asset != "" {
+		subject = fmt.Sprintf("Asset `%s` in pipeline `%s`", e.Asset, e.Pipeline)
+	}
+	if e.Check != "" {
+		target := e.Asset
+		if e.Column != "" {
+			target = e.Asset + "." + e.Column
+		}
+		subject = fmt.Sprintf("Check `%s` on `%s` in pipeline `%s`", e.Check, target, e.Pipeline)
+	}
+
+	if e.Status == StatusSuccess {
+		fmt.Fprintf(&b, "✅ %s succeeded", subject)
+	} else {
+		fmt.Fprintf(&b, "❌ %s failed", subject)
+	}
+
+	if e.RunID != "" {
+		fmt.Fprintf(&b, "\nRun ID: %s", e.RunID)
+	}
+	if e.Error != "" {
+		fmt.Fprintf(&b, "\nError: %s", e.Error)
+	}
+	if e.Summary != "" {
+		fmt.Fprintf(&b, "\n\n%s", e.Summary)
+	}
+
+	return b.String()
+}
+
+// Notifier delivers notifications to the targets defined in a pipeline, using the connections from the config.
+type Notifier struct {
+	connections *config.Connections
+	httpClient  *http.Client
+	slackAPIURL string
+}
+
+func NewNotifier(connections *config.Connections) *Notifier {
+	return &Notifier{
+		connections: connections,
+		httpClient:  &http.Client{Timeout: defaultTimeout},
+		slackAPIURL: defaultSlackAPIURL,
+	}
+}
+
+// Send delivers the event to every target in the given notifications that is enabled for the event status.
+// It attempts all the targets and returns the errors of the ones that failed.
+func (n *Notifier) Send(ctx context.Context, notifications *pipeline.Notifications, event *Event) error {
+	if notifications == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, target := range notifications.Slack {
+		if !isEnabled(target.NotificationCommon, event.Status) {
+			continue
+		}
+		if err := n.sendSlack(ctx, target, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send Slack notification to '%s': %w", target.Channel, err))
+		}
+	}
+
+	for _, target := range notifications.MSTeams {
+		if !isEnabled(target.NotificationCommon, event.Status) {
+			continue
+		}
+		if err := n.sendMSTeams(ctx, target, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send MS Teams notification via '%s': %w", target.Connection, err))
+		}
+	}
+
+	for _, target := range notifications.Discord {
+		if !isEnabled(target.NotificationCommon, event.Status) {
+			continue
+		}
+		if err := n.sendDiscord(ctx, target, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send Discord notification via '%s': %w", target.Connection, err))
+		}
+	}
+
+	for _, target := range notifications.Webhook {
+		if !isEnabled(target.NotificationCommon, event.Status) {
+			continue
+		}
+		if err := n.sendWebhook(ctx, target, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to send webhook notification via '%s': %w", target.Connection, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func isEnabled(common pipeline.NotificationCommon, status Status) bool {
+	if status == StatusSuccess {
+		return common.Success.Bool()
+	}
+
+	return common.Failure.Bool()
+}
+
+func (n *Notifier) sendSlack(ctx context.Context, target pipeline.SlackNotification, event *Event) error {
+	conn, err := n.slackConnection(target.Connection)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]string{
+		"channel": target.Channel,
+		"text":    event.Message(),
+	}
+
+	response, err := n.post(ctx, n.slackAPIURL, body, func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+conn.APIKey)
+	})
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(response, &result); err != nil {
+		return fmt.Errorf("failed to parse the Slack response: %w", err)
+	}
+	if !result.OK {
+		return fmt.Errorf("Slack API error: %s", result.Error)
+	}
+
+	return nil
+}
+
+// slackConnection resolves the Slack connection to use: the explicitly given one, `slack-default`, or the only
+// Slack connection in the environment.
+func (n *Notifier) slackConnection(name string) (*config.SlackConnection, error) {
+	if name != "" {
+		return getConnection[config.SlackConnection](n.connections, name, "slack")
+	}
+
+	if conn, ok := n.connections.Get(defaultSlackConnection).(*config.SlackConnection); ok {
+		return conn, nil
+	}
+
+	if n.connections != nil && len(n.connections.Slack) == 1 {
+		return &n.connections.Slack[0], nil
+	}
+
+	return nil, errors.New("no Slack connection found, either add a 'slack-default' connection or set the 'connection' field of the notification")
+}
+
+func (n *Notifier) sendMSTeams(ctx context.Context, target pipeline.MSTeamsNotification, event *Event) error {
+	conn, err := getConnection[config.MSTeamsConnection](n.connections, target.Connection, "ms_teams")
+	if err != nil {
+		return err
+	}
+
+	_, err = n.post(ctx, conn.WebhookURL, map[string]string{"text": event.Message()}, nil)
+	return err
+}
+
+func (n *Notifier) sendDiscord(ctx context.Context, target pipeline.DiscordNotification, event *Event) error {
+	conn, err := getConnection[config.DiscordConnection](n.connections, target.Connection, "discord")
+	if err != nil {
+		return err
+	}
+
+	message := event.Message()
+	if runes := []rune(message); len(runes) > discordMessageLimit {
+		message = string(runes[:discordMessageLimit-3]) + "..."
+	}
+
+	_, err = n.post(ctx, conn.WebhookURL, map[string]string{"content": message}, nil)
+	return err
+}
+
+type webhookPayload struct {
+	Pipeline string  `json:"pipeline"`
+	Asset    *string `json:"asset"`
+	Column   *string `json:"column"`
+	Check    *string `json:"check"`
+	RunID    string  `json:"run_id"`
+	Status   Status  `json:"status"`
+	Summary  string  `json:"summary,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+func (n *Notifier) sendWebhook(ctx context.Context, target pipeline.WebhookNotification, event *Event) error {
+	conn, err := getConnection[config.WebhookConnection](n.connections, target.Connection, "webhook")
+	if err != nil {
+		return err
+	}
+
+	payload := webhookPayload{
+		Pipeline: event.Pipeline,
+		Asset:    nullableString(event.Asset),
+		Column:   nullableString(event.Column),
+		Check:    nullableString(event.Check),
+		RunID:    event.RunID,
+		Status:   event.Status,
+		Summary:  event.Summary,
+		Error:    event.Error,
+	}
+
+	_, err = n.post(ctx, conn.URL, payload, func(req *http.Request) {
+		if conn.Username != "" || conn.Password != "" {
+			req.SetBasicAuth(conn.Username, conn.Password)
+		}
+	})
+	return err
+}
+
+func (n *Notifier) post(ctx context.Context, url string, body any, prepare func(req *http.Request)) ([]byte, error) {
+	if url == "" {
+		return nil, errors.New("the connection does not have a URL")
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if prepare != nil {
+		prepare(req)
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return respBody, nil
+}
+
+func getConnection[T any](connections *config.Connections, name, connectionType string) (*T, error) {
+	if name == "" {
+		return nil, errors.New("the notification does not have a connection")
+	}
+
+	conn, ok := connections.Get(name).(*T)
+	if !ok {
+		return nil, fmt.Errorf("%s connection '%s' not found", connectionType, name)
+	}
+
+	return conn, nil
+}
+
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,171 @@
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	Path     string
+	Auth     string
+	Username string
+	Password string
+	Body     map[string]any
+}
+
+type recorder struct {
+	mu       sync.Mutex
+	requests []recordedRequest
+}
+
+func (r *recorder) handler(t *testing.T, response string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+
+		username, password, _ := req.BasicAuth()
+		r.mu.Lock()
+		r.requests = append(r.requests, recordedRequest{
+			Path:     req.URL.Path,
+			Auth:     req.Header.Get("Authorization"),
+			Username: username,
+			Password: password,
+			Body:     body,
+		})
+		r.mu.Unlock()
+
+		_, _ = w.Write([]byte(response))
+	}
+}
+
+func newTestNotifier(t *testing.T, response string) (*Notifier, *recorder) {
+	t.Helper()
+	rec := &recorder{}
+	server := httptest.NewServer(rec.handler(t, response))
+	t.Cleanup(server.Close)
+
+	connections := &config.Connections{
+		Slack:   []config.SlackConnection{{Name: "slack-default", APIKey: "xoxb-token"}},
+		MSTeams: []config.MSTeamsConnection{{Name: "teams", WebhookURL: server.URL + "/teams"}},
+		Discord: []config.DiscordConnection{{Name: "discord", WebhookURL: server.URL + "/discord"}},
+		Webhook: []config.WebhookConnection{{Name: "hook", URL: server.URL + "/hook", Username: "user", Password: "pass"}},
+	}
+
+	n := NewNotifier(connections)
+	n.slackAPIURL = server.URL + "/slack"
+	return n, rec
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestNotifier_Send(t *testing.T) {
+	t.Parallel()
+
+	n, rec := newTestNotifier(t, `{"ok": true}`)
+	notifications := &pipeline.Notifications{
+		Slack:   []pipeline.SlackNotification{{Channel: "#alerts"}},
+		MSTeams: []pipeline.MSTeamsNotification{{Connection: "teams"}},
+		Discord: []pipeline.DiscordNotification{{Connection: "discord"}},
+		Webhook: []pipeline.WebhookNotification{{Connection: "hook"}},
+	}
+
+	err := n.Send(t.Context(), notifications, &Event{
+		Pipeline: "daily_orders",
+		Asset:    "orders",
+		RunID:    "run-1",
+		Status:   StatusFailure,
+		Summary:  "Assets: 1 failed",
+		Error:    "boom",
+	})
+	require.NoError(t, err)
+	require.Len(t, rec.requests, 4)
+
+	byPath := make(map[string]recordedRequest)
+	for _, req := range rec.requests {
+		byPath[req.Path] = req
+	}
+
+	slack := byPath["/slack"]
+	assert.Equal(t, "Bearer xoxb-token", slack.Auth)
+	assert.Equal(t, "#alerts", slack.Body["channel"])
+	assert.Contains(t, slack.Body["text"], "❌ Asset `orders` in pipeline `daily_orders` failed")
+	assert.Contains(t, slack.Body["text"], "Assets: 1 failed")
+
+	assert.Contains(t, byPath["/teams"].Body["text"], "Error: boom")
+	assert.Contains(t, byPath["/discord"].Body["content"], "Run ID: run-1")
+
+	hook := byPath["/hook"]
+	assert.Equal(t, "user", hook.Username)
+	assert.Equal(t, "pass", hook.Password)
+	assert.Equal(t, "daily_orders", hook.Body["pipeline"])
+	assert.Equal(t, "orders", hook.Body["asset"])
+	assert.Nil(t, hook.Body["column"])
+	assert.Nil(t, hook.Body["check"])
+	assert.Equal(t, "run-1", hook.Body["run_id"])
+	assert.Equal(t, "failure", hook.Body["status"])
+}
+
+func TestNotifier_SendHonorsStatusFlags(t *testing.T) {
+	t.Parallel()
+
+	n, rec := newTestNotifier(t, `{"ok": true}`)
+	notifications := &pipeline.Notifications{
+		MSTeams: []pipeline.MSTeamsNotification{
+			{Connection: "teams", NotificationCommon: pipeline.NotificationCommon{Failure: pipeline.DefaultTrueBool{Value: boolPtr(false)}}},
+		},
+		Discord: []pipeline.DiscordNotification{
+			{Connection: "discord", NotificationCommon: pipeline.NotificationCommon{Success: pipeline.DefaultTrueBool{Value: boolPtr(false)}}},
+		},
+	}
+
+	require.NoError(t, n.Send(t.Context(), notifications, &Event{Pipeline: "p", Status: StatusSuccess}))
+	require.Len(t, rec.requests, 1)
+	assert.Equal(t, "/teams", rec.requests[0].Path)
+
+	require.NoError(t, n.Send(t.Context(), notifications, &Event{Pipeline: "p", Status: StatusFailure}))
+	require.Len(t, rec.requests, 2)
+	assert.Equal(t, "/discord", rec.requests[1].Path)
+}
+
+func TestNotifier_SendReportsErrors(t *testing.T) {
+	t.Parallel()
+
+	n, rec := newTestNotifier(t, `{"ok": false, "error": "channel_not_found"}`)
+	notifications := &pipeline.Notifications{
+		Slack:   []pipeline.SlackNotification{{Channel: "#missing"}},
+		MSTeams: []pipeline.MSTeamsNotification{{Connection: "does-not-exist"}},
+		Discord: []pipeline.DiscordNotification{{Connection: "discord"}},
+	}
+
+	err := n.Send(t.Context(), notifications, &Event{Pipeline: "p", Status: StatusFailure})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Slack API error: channel_not_found")
+	assert.Contains(t, err.Error(), "ms_teams connection 'does-not-exist' not found")
+	assert.NotContains(t, err.Error(), "Discord")
+
+	// the failing targets must not prevent the rest from being notified
+	assert.Len(t, rec.requests, 2)
+}
+
+func TestEvent_Message(t *testing.T) {
+	t.Parallel()
+
+	event := &Event{Pipeline: "p", Asset: "a", Column: "id", Check: "not_null", Status: StatusFailure}
+	assert.Equal(t, "❌ Check `not_null` on `a.id` in pipeline `p` failed", event.Message())
+
+	event = &Event{Pipeline: "p", RunID: "r", Status: StatusSuccess, Summary: "all good"}
+	assert.Equal(t, "✅ Pipeline `p` succeeded\nRun ID: r\n\nall good", event.Message())
+}
@@ -36,11 +36,24 @@ func NewClient(c Config) (*Client, error) {
 
 func (db *Client) RunQueryWithoutResult(ctx context.Context, query *query.Query) error {
 	queryStr := strings.TrimSpace(query.String())
-	queryStr = strings.TrimSuffix(queryStr, ";")
+	// PL/SQL blocks must keep their terminating semicolon, plain SQL statements must not have one
+	if !isPLSQLBlock(queryStr) {
+		queryStr = strings.TrimSuffix(queryStr, ";")
+	}
 	_, err := db.conn.ExecContext(ctx, queryStr)
 	return errors.Wrap(err, "failed to execute query")
 }
 
+func isPLSQLBlock(queryStr string) bool {
+	words := strings.Fields(queryStr)
+	if len(words) == 0 {
+		return false
+	}
+
+	firstWord := strings.ToUpper(words[0])
+	return firstWord == "BEGIN" || firstWord == "DECLARE"
+}
+
 func (db *Client) Select(ctx context.Context, query *query.Query) ([][]interface{}, error) {
 	queryStr := strings.TrimSpace(query.String())
 	queryStr = strings.TrimSuffix(queryStr, ";")
@@ -79,6 +92,12 @@ func (db *Client) Select(ctx context.Context, query *query.Query) ([][]interface
 	return result, nil
 }
 
+// Limit bounds the rows of the query with ROWNUM, which unlike FETCH FIRST works in every Oracle version.
+func (db *Client) Limit(query string, limit int64) string {
+	query = strings.TrimRight(query, "; \n\t")
+	return fmt.Sprintf("SELECT * FROM (\n%s\n) WHERE ROWNUM <= %d", query, limit)
+}
+
 func (db *Client) Ping(ctx context.Context) error {
 	// Simple ping query
 	q := &query.Query{Query: "SELECT 1 FROM DUAL"}
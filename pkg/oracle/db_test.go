@@ -341,6 +341,28 @@ func TestClient_RunQueryWithoutResult(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "trailing semicolon is removed from plain statements",
+			mockConnection: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`INSERT INTO users SELECT * FROM staging`).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			query: &query.Query{
+				Query: "INSERT INTO users SELECT * FROM staging;",
+			},
+			wantErr: false,
+		},
+		{
+			name: "trailing semicolon is kept for PL/SQL blocks",
+			mockConnection: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("begin\nEXECUTE IMMEDIATE 'DROP TABLE users';\nEND;").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			query: &query.Query{
+				Query: "begin\nEXECUTE IMMEDIATE 'DROP TABLE users';\nEND;\n",
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid query returns error",
 			mockConnection: func(mock sqlmock.Sqlmock) {
@@ -0,0 +1,198 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bruin-data/bruin/pkg/ansisql"
+	"github.com/bruin-data/bruin/pkg/helpers"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+)
+
+var matMap = pipeline.AssetMaterializationMap{
+	pipeline.MaterializationTypeView: {
+		pipeline.MaterializationStrategyNone:          viewMaterializer,
+		pipeline.MaterializationStrategyAppend:        errorMaterializer,
+		pipeline.MaterializationStrategyCreateReplace: errorMaterializer,
+		pipeline.MaterializationStrategyDeleteInsert:  errorMaterializer,
+	},
+	pipeline.MaterializationTypeTable: {
+		pipeline.MaterializationStrategyNone:          buildCreateReplaceQuery,
+		pipeline.MaterializationStrategyAppend:        buildAppendQuery,
+		pipeline.MaterializationStrategyCreateReplace: buildCreateReplaceQuery,
+		pipeline.MaterializationStrategyDeleteInsert:  buildIncrementalQuery,
+		pipeline.MaterializationStrategyMerge:         buildMergeQuery,
+		pipeline.MaterializationStrategyTimeInterval:  buildTimeIntervalQuery,
+	},
+}
+
+func NewMaterializer(fullRefresh bool) *pipeline.Materializer {
+	return &pipeline.Materializer{
+		MaterializationMap: matMap,
+		FullRefresh:        fullRefresh,
+	}
+}
+
+func errorMaterializer(asset *pipeline.Asset, query string) (string, error) {
+	return "", fmt.Errorf("materialization strategy %s is not supported for materialization type %s and asset type %s", asset.Materialization.Strategy, asset.Materialization.Type, asset.Type)
+}
+
+func viewMaterializer(asset *pipeline.Asset, query string) (string, error) {
+	return fmt.Sprintf("CREATE OR REPLACE VIEW %s AS\n%s", asset.Name, trimQuery(query)), nil
+}
+
+func buildCreateReplaceQuery(task *pipeline.Asset, query string) (string, error) {
+	mat := task.Materialization
+
+	if len(mat.ClusterBy) > 0 {
+		return "", errors.New("Oracle assets do not support `cluster_by`")
+	}
+
+	return plsqlBlock(
+		dropTableIfExists(task.Name),
+		executeImmediate(fmt.Sprintf("CREATE TABLE %s AS %s", task.Name, trimQuery(query))),
+	), nil
+}
+
+func buildAppendQuery(asset *pipeline.Asset, query string) (string, error) {
+	return fmt.Sprintf("INSERT INTO %s %s", asset.Name, trimQuery(query)), nil
+}
+
+func buildIncrementalQuery(task *pipeline.Asset, query string) (string, error) {
+	mat := task.Materialization
+	strategy := pipeline.MaterializationStrategyDeleteInsert
+
+	if mat.IncrementalKey == "" {
+		return "", fmt.Errorf("materialization strategy %s requires the `incremental_key` field to be set", strategy)
+	}
+
+	// unquoted identifiers cannot start with an underscore in Oracle
+	tempTableName := "bruin_tmp_" + helpers.PrefixGenerator()
+
+	// the temporary table is a regular table, it is dropped when any of the steps fails so that it does not outlive
+	// the run. DDL commits implicitly, the partial delete is rolled back before dropping it.
+	return plsqlBlockWithCleanup(
+		[]string{
+			executeImmediate(fmt.Sprintf("CREATE TABLE %s AS %s", tempTableName, trimQuery(query))),
+			executeImmediate(fmt.Sprintf("DELETE FROM %s WHERE %s IN (SELECT DISTINCT %s FROM %s)", task.Name, mat.IncrementalKey, mat.IncrementalKey, tempTableName)),
+			executeImmediate(fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", task.Name, tempTableName)),
+			"COMMIT;",
+			executeImmediate("DROP TABLE " + tempTableName),
+		},
+		"ROLLBACK;",
+		dropTableIfExists(tempTableName),
+	), nil
+}
+
+func buildMergeQuery(asset *pipeline.Asset, query string) (string, error) {
+	if len(asset.Columns) == 0 {
+		return "", fmt.Errorf("materialization strategy %s requires the `columns` field to be set", asset.Materialization.Strategy)
+	}
+
+	primaryKeys := asset.ColumnNamesWithPrimaryKey()
+	if len(primaryKeys) == 0 {
+		return "", fmt.Errorf("materialization strategy %s requires the `primary_key` field to be set on at least one column", asset.Materialization.Strategy)
+	}
+
+	mergeColumns := ansisql.GetColumnsWithMergeLogic(asset)
+	columnNames := asset.ColumnNames()
+
+	on := make([]string, 0, len(primaryKeys))
+	for _, key := range primaryKeys {
+		on = append(on, fmt.Sprintf("target.%s = source.%s", key, key))
+	}
+	onQuery := strings.Join(on, " AND ")
+
+	sourceValues := make([]string, 0, len(columnNames))
+	for _, col := range columnNames {
+		sourceValues = append(sourceValues, "source."+col)
+	}
+
+	mergeLines := []string{
+		"MERGE INTO " + asset.Name + " target",
+		fmt.Sprintf("USING (%s) source ON (%s)", trimQuery(query), onQuery),
+	}
+
+	if len(mergeColumns) > 0 {
+		matchedUpdateStatements := make([]string, 0, len(mergeColumns))
+		for _, col := range mergeColumns {
+			if col.MergeSQL != "" {
+				matchedUpdateStatements = append(matchedUpdateStatements, fmt.Sprintf("target.%s = %s", col.Name, col.MergeSQL))
+			} else {
+				matchedUpdateStatements = append(matchedUpdateStatements, fmt.Sprintf("target.%s = source.%s", col.Name, col.Name))
+			}
+		}
+
+		mergeLines = append(mergeLines, "WHEN MATCHED THEN UPDATE SET "+strings.Join(matchedUpdateStatements, ", "))
+	}
+
+	mergeLines = append(mergeLines, fmt.Sprintf("WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)", strings.Join(columnNames, ", "), strings.Join(sourceValues, ", ")))
+
+	return strings.Join(mergeLines, "\n"), nil
+}
+
+func buildTimeIntervalQuery(asset *pipeline.Asset, query string) (string, error) {
+	if asset.Materialization.IncrementalKey == "" {
+		return "", errors.New("incremental_key is required for time_interval strategy")
+	}
+
+	if asset.Materialization.TimeGranularity == "" {
+		return "", errors.New("time_granularity is required for time_interval strategy")
+	}
+
+	if asset.Materialization.TimeGranularity != pipeline.MaterializationTimeGranularityTimestamp && asset.Materialization.TimeGranularity != pipeline.MaterializationTimeGranularityDate {
+		return "", errors.New("time_granularity must be either 'date', or 'timestamp'")
+	}
+
+	// Oracle cannot parse the timezone suffix of the rendered timestamps, the microsecond precision part is kept instead
+	startVar := "TO_TIMESTAMP(SUBSTR('{{start_timestamp}}', 1, 26), 'YYYY-MM-DD\"T\"HH24:MI:SS.FF6')"
+	endVar := "TO_TIMESTAMP(SUBSTR('{{end_timestamp}}', 1, 26), 'YYYY-MM-DD\"T\"HH24:MI:SS.FF6')"
+	if asset.Materialization.TimeGranularity == pipeline.MaterializationTimeGranularityDate {
+		startVar = "DATE '{{start_date}}'"
+		endVar = "DATE '{{end_date}}'"
+	}
+
+	return plsqlBlock(
+		executeImmediate(fmt.Sprintf("DELETE FROM %s WHERE %s BETWEEN %s AND %s", asset.Name, asset.Materialization.IncrementalKey, startVar, endVar)),
+		executeImmediate(fmt.Sprintf("INSERT INTO %s %s", asset.Name, trimQuery(query))),
+		"COMMIT;",
+	), nil
+}
+
+// plsqlBlock wraps the given statements in an anonymous PL/SQL block, Oracle cannot run multiple statements in a
+// single call otherwise.
+func plsqlBlock(statements ...string) string {
+	return "BEGIN\n" + strings.Join(statements, "\n") + "\nEND;"
+}
+
+// plsqlBlockWithCleanup is a plsqlBlock that runs the cleanup statements when any of the statements fails, and then
+// raises the original error again.
+func plsqlBlockWithCleanup(statements []string, cleanup ...string) string {
+	block := slices.Concat(statements, []string{"EXCEPTION WHEN OTHERS THEN"}, cleanup, []string{"RAISE;"})
+
+	return plsqlBlock(block...)
+}
+
+// executeImmediate runs the statement as dynamic SQL, which allows DDL statements inside PL/SQL blocks and defers
+// the resolution of the tables that are created earlier in the same block.
+func executeImmediate(statement string) string {
+	return fmt.Sprintf("EXECUTE IMMEDIATE '%s';", strings.ReplaceAll(statement, "'", "''"))
+}
+
+// dropTableIfExists drops the table while ignoring ORA-00942, Oracle does not support `DROP TABLE IF EXISTS`
+// before 23c.
+func dropTableIfExists(table string) string {
+	return strings.Join([]string{
+		"BEGIN",
+		executeImmediate("DROP TABLE " + table),
+		"EXCEPTION WHEN OTHERS THEN",
+		"IF SQLCODE != -942 THEN RAISE; END IF;",
+		"END;",
+	}, "\n")
+}
+
+func trimQuery(query string) string {
+	return strings.TrimSuffix(strings.TrimSpace(query), ";")
+}
@@ -0,0 +1,273 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaterializer_Render(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		task        *pipeline.Asset
+		query       string
+		want        string
+		wantErr     bool
+		fullRefresh bool
+	}{
+		{
+			name:  "no materialization, return raw query",
+			task:  &pipeline.Asset{},
+			query: "SELECT 1 FROM DUAL",
+			want:  "SELECT 1 FROM DUAL",
+		},
+		{
+			name: "materialize to a view",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type: pipeline.MaterializationTypeView,
+				},
+			},
+			query: "SELECT 1 FROM DUAL;",
+			want:  "CREATE OR REPLACE VIEW my.asset AS\nSELECT 1 FROM DUAL",
+		},
+		{
+			name: "materialize to a table, no strategy defaults to create+replace",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type: pipeline.MaterializationTypeTable,
+				},
+			},
+			query: "SELECT 'a' AS name FROM DUAL;",
+			want: "BEGIN\n" +
+				"BEGIN\n" +
+				"EXECUTE IMMEDIATE 'DROP TABLE my.asset';\n" +
+				"EXCEPTION WHEN OTHERS THEN\n" +
+				"IF SQLCODE != -942 THEN RAISE; END IF;\n" +
+				"END;\n" +
+				"EXECUTE IMMEDIATE 'CREATE TABLE my.asset AS SELECT ''a'' AS name FROM DUAL';\n" +
+				"END;",
+		},
+		{
+			name: "full refresh turns an incremental strategy into create+replace",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:           pipeline.MaterializationTypeTable,
+					Strategy:       pipeline.MaterializationStrategyDeleteInsert,
+					IncrementalKey: "dt",
+				},
+			},
+			fullRefresh: true,
+			query:       "SELECT 1 AS dt FROM DUAL",
+			want: "BEGIN\n" +
+				"BEGIN\n" +
+				"EXECUTE IMMEDIATE 'DROP TABLE my.asset';\n" +
+				"EXCEPTION WHEN OTHERS THEN\n" +
+				"IF SQLCODE != -942 THEN RAISE; END IF;\n" +
+				"END;\n" +
+				"EXECUTE IMMEDIATE 'CREATE TABLE my.asset AS SELECT 1 AS dt FROM DUAL';\n" +
+				"END;",
+		},
+		{
+			name: "materialize to a table with cluster is unsupported",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:      pipeline.MaterializationTypeTable,
+					ClusterBy: []string{"dt"},
+				},
+			},
+			query:   "SELECT 1 FROM DUAL",
+			wantErr: true,
+		},
+		{
+			name: "materialize to a table with append",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:     pipeline.MaterializationTypeTable,
+					Strategy: pipeline.MaterializationStrategyAppend,
+				},
+			},
+			query: "SELECT 1 FROM DUAL;",
+			want:  "INSERT INTO my.asset SELECT 1 FROM DUAL",
+		},
+		{
+			name: "delete+insert requires the incremental_key to be set",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:     pipeline.MaterializationTypeTable,
+					Strategy: pipeline.MaterializationStrategyDeleteInsert,
+				},
+			},
+			query:   "SELECT 1 FROM DUAL",
+			wantErr: true,
+		},
+		{
+			name: "delete+insert goes through a temporary table that is dropped on failure",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:           pipeline.MaterializationTypeTable,
+					Strategy:       pipeline.MaterializationStrategyDeleteInsert,
+					IncrementalKey: "dt",
+				},
+			},
+			query: "SELECT DATE '2024-01-01' AS dt FROM DUAL;",
+			want: "BEGIN\n" +
+				"EXECUTE IMMEDIATE 'CREATE TABLE bruin_tmp_abcefghi AS SELECT DATE ''2024-01-01'' AS dt FROM DUAL';\n" +
+				"EXECUTE IMMEDIATE 'DELETE FROM my.asset WHERE dt IN (SELECT DISTINCT dt FROM bruin_tmp_abcefghi)';\n" +
+				"EXECUTE IMMEDIATE 'INSERT INTO my.asset SELECT * FROM bruin_tmp_abcefghi';\n" +
+				"COMMIT;\n" +
+				"EXECUTE IMMEDIATE 'DROP TABLE bruin_tmp_abcefghi';\n" +
+				"EXCEPTION WHEN OTHERS THEN\n" +
+				"ROLLBACK;\n" +
+				"BEGIN\n" +
+				"EXECUTE IMMEDIATE 'DROP TABLE bruin_tmp_abcefghi';\n" +
+				"EXCEPTION WHEN OTHERS THEN\n" +
+				"IF SQLCODE != -942 THEN RAISE; END IF;\n" +
+				"END;\n" +
+				"RAISE;\n" +
+				"END;",
+		},
+		{
+			name: "merge without columns fails",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:     pipeline.MaterializationTypeTable,
+					Strategy: pipeline.MaterializationStrategyMerge,
+				},
+			},
+			query:   "SELECT 1 AS id FROM DUAL",
+			wantErr: true,
+		},
+		{
+			name: "merge without a primary key fails",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:     pipeline.MaterializationTypeTable,
+					Strategy: pipeline.MaterializationStrategyMerge,
+				},
+				Columns: []pipeline.Column{{Name: "id"}},
+			},
+			query:   "SELECT 1 AS id FROM DUAL",
+			wantErr: true,
+		},
+		{
+			name: "merge updates the columns with merge logic",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:     pipeline.MaterializationTypeTable,
+					Strategy: pipeline.MaterializationStrategyMerge,
+				},
+				Columns: []pipeline.Column{
+					{Name: "id", PrimaryKey: true},
+					{Name: "name", UpdateOnMerge: true},
+					{Name: "total", MergeSQL: "target.total + source.total"},
+					{Name: "created_at"},
+				},
+			},
+			query: "SELECT id, name, total, created_at FROM staging;",
+			want: "MERGE INTO my.asset target\n" +
+				"USING (SELECT id, name, total, created_at FROM staging) source ON (target.id = source.id)\n" +
+				"WHEN MATCHED THEN UPDATE SET target.name = source.name, target.total = target.total + source.total\n" +
+				"WHEN NOT MATCHED THEN INSERT (id, name, total, created_at) VALUES (source.id, source.name, source.total, source.created_at)",
+		},
+		{
+			name: "merge without merge logic only inserts",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:     pipeline.MaterializationTypeTable,
+					Strategy: pipeline.MaterializationStrategyMerge,
+				},
+				Columns: []pipeline.Column{
+					{Name: "id", PrimaryKey: true},
+					{Name: "name"},
+				},
+			},
+			query: "SELECT id, name FROM staging",
+			want: "MERGE INTO my.asset target\n" +
+				"USING (SELECT id, name FROM staging) source ON (target.id = source.id)\n" +
+				"WHEN NOT MATCHED THEN INSERT (id, name) VALUES (source.id, source.name)",
+		},
+		{
+			name: "time_interval requires a valid granularity",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:            pipeline.MaterializationTypeTable,
+					Strategy:        pipeline.MaterializationStrategyTimeInterval,
+					IncrementalKey:  "dt",
+					TimeGranularity: "hour",
+				},
+			},
+			query:   "SELECT 1 FROM DUAL",
+			wantErr: true,
+		},
+		{
+			name: "time_interval with date granularity",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:            pipeline.MaterializationTypeTable,
+					Strategy:        pipeline.MaterializationStrategyTimeInterval,
+					IncrementalKey:  "dt",
+					TimeGranularity: pipeline.MaterializationTimeGranularityDate,
+				},
+			},
+			query: "SELECT dt, name FROM staging",
+			want: "BEGIN\n" +
+				"EXECUTE IMMEDIATE 'DELETE FROM my.asset WHERE dt BETWEEN DATE ''{{start_date}}'' AND DATE ''{{end_date}}''';\n" +
+				"EXECUTE IMMEDIATE 'INSERT INTO my.asset SELECT dt, name FROM staging';\n" +
+				"COMMIT;\n" +
+				"END;",
+		},
+		{
+			name: "time_interval with timestamp granularity",
+			task: &pipeline.Asset{
+				Name: "my.asset",
+				Materialization: pipeline.Materialization{
+					Type:            pipeline.MaterializationTypeTable,
+					Strategy:        pipeline.MaterializationStrategyTimeInterval,
+					IncrementalKey:  "ts",
+					TimeGranularity: pipeline.MaterializationTimeGranularityTimestamp,
+				},
+			},
+			query: "SELECT ts, name FROM staging",
+			want: "BEGIN\n" +
+				"EXECUTE IMMEDIATE 'DELETE FROM my.asset WHERE ts BETWEEN " +
+				"TO_TIMESTAMP(SUBSTR(''{{start_timestamp}}'', 1, 26), ''YYYY-MM-DD\"T\"HH24:MI:SS.FF6'') AND " +
+				"TO_TIMESTAMP(SUBSTR(''{{end_timestamp}}'', 1, 26), ''YYYY-MM-DD\"T\"HH24:MI:SS.FF6'')';\n" +
+				"EXECUTE IMMEDIATE 'INSERT INTO my.asset SELECT ts, name FROM staging';\n" +
+				"COMMIT;\n" +
+				"END;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewMaterializer(tt.fullRefresh)
+			render, err := m.Render(tt.task, tt.query)
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, render)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockExtractor struct {
+	mock.Mock
+}
+
+func (m *mockExtractor) ExtractQueriesFromString(content string) ([]*query.Query, error) {
+	res := m.Called(content)
+	return res.Get(0).([]*query.Query), res.Error(1)
+}
+
+func (m *mockExtractor) CloneForAsset(ctx context.Context, pipeline *pipeline.Pipeline, asset *pipeline.Asset) (query.QueryExtractor, error) {
+	return m, nil
+}
+
+func (m *mockExtractor) ReextractQueriesFromSlice(content []string) ([]string, error) {
+	res := m.Called(content)
+	return res.Get(0).([]string), res.Error(1)
+}
+
+type mockMaterializer struct {
+	mock.Mock
+}
+
+func (m *mockMaterializer) Render(t *pipeline.Asset, query string) (string, error) {
+	res := m.Called(t, query)
+	return res.Get(0).(string), res.Error(1)
+}
+
+func TestBasicOperator_RunTask(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		t *pipeline.Asset
+	}
+
+	type fields struct {
+		q *mockQuerierWithResult
+		e *mockExtractor
+		m *mockMaterializer
+	}
+
+	tests := []struct {
+		name              string
+		setup             func(f *fields)
+		setupQueries      func(m *mockQuerierWithResult)
+		setupExtractor    func(m *mockExtractor)
+		setupMaterializer func(m *mockMaterializer)
+		args              args
+		wantErr           bool
+	}{
+		{
+			name: "failed to extract queries",
+			setup: func(f *fields) {
+				f.e.On("ExtractQueriesFromString", "some content").
+					Return([]*query.Query{}, errors.New("failed to extract queries"))
+			},
+			args: args{
+				t: &pipeline.Asset{
+					ExecutableFile: pipeline.ExecutableFile{
+						Path:    "test-file.sql",
+						Content: "some content",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no queries found in file",
+			setup: func(f *fields) {
+				f.e.On("ExtractQueriesFromString", "some content").
+					Return([]*query.Query{}, nil)
+			},
+			args: args{
+				t: &pipeline.Asset{
+					ExecutableFile: pipeline.ExecutableFile{
+						Path:    "test-file.sql",
+						Content: "some content",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "multiple queries found but materialization is enabled, should fail",
+			setup: func(f *fields) {
+				f.e.On("ExtractQueriesFromString", "some content").
+					Return([]*query.Query{
+						{Query: "query 1"},
+						{Query: "query 2"},
+					}, nil)
+			},
+			args: args{
+				t: &pipeline.Asset{
+					ExecutableFile: pipeline.ExecutableFile{
+						Path:    "test-file.sql",
+						Content: "some content",
+					},
+					Materialization: pipeline.Materialization{
+						Type: pipeline.MaterializationTypeTable,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "query returned an error",
+			setup: func(f *fields) {
+				f.e.On("ExtractQueriesFromString", "some content").
+					Return([]*query.Query{
+						{Query: "SELECT * FROM users"},
+					}, nil)
+
+				f.m.On("Render", mock.Anything, "SELECT * FROM users").
+					Return("SELECT * FROM users", nil)
+
+				f.q.On("RunQueryWithoutResult", mock.Anything, &query.Query{Query: "SELECT * FROM users"}).
+					Return(errors.New("failed to run query"))
+			},
+			args: args{
+				t: &pipeline.Asset{
+					Type: pipeline.AssetTypeOracleQuery,
+					ExecutableFile: pipeline.ExecutableFile{
+						Path:    "test-file.sql",
+						Content: "some content",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "query successfully executed",
+			setup: func(f *fields) {
+				f.e.On("ExtractQueriesFromString", "some content").
+					Return([]*query.Query{
+						{Query: "SELECT * FROM users"},
+					}, nil)
+
+				f.m.On("Render", mock.Anything, "SELECT * FROM users").
+					Return("SELECT * FROM users", nil)
+
+				f.q.On("RunQueryWithoutResult", mock.Anything, &query.Query{Query: "SELECT * FROM users"}).
+					Return(nil)
+			},
+			args: args{
+				t: &pipeline.Asset{
+					Type: pipeline.AssetTypeOracleQuery,
+					ExecutableFile: pipeline.ExecutableFile{
+						Path:    "test-file.sql",
+						Content: "some content",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "query successfully executed with materialization",
+			setup: func(f *fields) {
+				f.e.On("ExtractQueriesFromString", "some content").
+					Return([]*query.Query{
+						{Query: "SELECT * FROM users"},
+					}, nil)
+
+				f.m.On("Render", mock.Anything, "SELECT * FROM users").
+					Return("CREATE TABLE x AS SELECT * FROM users", nil)
+
+				f.q.On("RunQueryWithoutResult", mock.Anything, &query.Query{Query: "CREATE TABLE x AS SELECT * FROM users"}).
+					Return(nil)
+			},
+			args: args{
+				t: &pipeline.Asset{
+					Type: pipeline.AssetTypeOracleQuery,
+					ExecutableFile: pipeline.ExecutableFile{
+						Path:    "test-file.sql",
+						Content: "some content",
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := new(mockQuerierWithResult)
+			extractor := new(mockExtractor)
+			mat := new(mockMaterializer)
+			conn := new(mockConnectionFetcher)
+			conn.On("GetConnection", "oracle-default").Return(client)
+
+			if tt.setup != nil {
+				tt.setup(&fields{
+					q: client,
+					e: extractor,
+					m: mat,
+				})
+			}
+
+			o := BasicOperator{
+				connection:   conn,
+				extractor:    extractor,
+				materializer: mat,
+			}
+
+			err := o.RunTask(t.Context(), &pipeline.Pipeline{}, tt.args.t)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
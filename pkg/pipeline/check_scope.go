@@ -0,0 +1,55 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CheckScope tells which rows of the asset a quality check runs on.
+type CheckScope string
+
+const (
+	// CheckScopeTable runs the check on the whole table, it is the default.
+	CheckScopeTable CheckScope = "table"
+	// CheckScopeInterval runs the check only on the rows written in the interval of the run, which is much cheaper
+	// than scanning the whole table for the incremental assets.
+	CheckScopeInterval CheckScope = "interval"
+)
+
+func (s CheckScope) IsValid() bool {
+	return s == "" || s == CheckScopeTable || s == CheckScopeInterval
+}
+
+// ValidateIntervalScope returns an error if the checks of the asset cannot be scoped to the rows written in the run
+// interval, which requires the asset to be materialized incrementally by a time `incremental_key`. The time_interval
+// strategy always writes the rows of the interval, while the key of delete+insert can be anything, e.g. an ID, so it
+// must be declared as a date or timestamp column.
+func (a *Asset) ValidateIntervalScope() error {
+	switch a.Materialization.Strategy {
+	case MaterializationStrategyTimeInterval, MaterializationStrategyDeleteInsert:
+	default:
+		return fmt.Errorf("checks with the '%s' scope require the asset to use the '%s' or '%s' materialization strategy",
+			CheckScopeInterval, MaterializationStrategyTimeInterval, MaterializationStrategyDeleteInsert)
+	}
+
+	if a.Materialization.IncrementalKey == "" {
+		return fmt.Errorf("checks with the '%s' scope require the asset to have an 'incremental_key'", CheckScopeInterval)
+	}
+
+	if a.Materialization.Strategy == MaterializationStrategyDeleteInsert {
+		column := a.GetColumnWithName(a.Materialization.IncrementalKey)
+		if column == nil || !isTimeColumnType(column.Type) {
+			return fmt.Errorf("checks with the '%s' scope require the 'incremental_key' of a '%s' asset to be a column with a date or timestamp type",
+				CheckScopeInterval, MaterializationStrategyDeleteInsert)
+		}
+	}
+
+	return nil
+}
+
+// isTimeColumnType tells if the declared type of a column holds dates or timestamps, e.g. DATE, DATETIME2 or
+// TIMESTAMP_NTZ(9), on any of the platforms.
+func isTimeColumnType(columnType string) bool {
+	columnType = strings.ToLower(strings.TrimSpace(columnType))
+	return strings.HasPrefix(columnType, "date") || strings.HasPrefix(columnType, "timestamp") || columnType == "smalldatetime"
+}
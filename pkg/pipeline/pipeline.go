@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -133,6 +135,7 @@ const (
 	AssetTypeVerticaTableSensor        = AssetType("vertica.sensor.table")
 	RunConfigApplyIntervalModifiers    = RunConfig("apply-interval-modifiers")
 	RunConfigEndDate                   = RunConfig("end-date")
+	RunConfigFailingRowsSample         = RunConfig("failing-rows-sample")
 	RunConfigFullRefresh               = RunConfig("full-refresh")
 	RunConfigQueryAnnotations          = RunConfig("query-annotations")
 	RunConfigRunID                     = RunConfig("run-id")
@@ -192,8 +195,14 @@ var defaultMapping = map[string]string{
 	"motherduck":            "motherduck-default",
 	"elasticsearch":         "elasticsearch-default",
 	"vertica":               "vertica-default",
+	"looker":                "looker-default",
+	"powerbi":               "powerbi-default",
+	"metabase":              "metabase-default",
 }
 
+// refCallRegex finds the ref() calls with a literal name inside the Jinja expressions and statements of a query.
+var refCallRegex = regexp.MustCompile(`\{[{%][^}]*?\bref\(\s*['"]([^'"]+)['"]\s*\)`)
+
 var SupportedFileSuffixes = []string{"asset.yml", "asset.yaml", ".sql", ".py", ".r", "task.yml", "task.yaml"}
 
 type (
@@ -293,6 +302,7 @@ type NotificationCommon struct {
 
 type SlackNotification struct {
 	Channel            string `json:"channel"`
+	Connection         string `yaml:"connection,omitempty" json:"connection,omitempty" mapstructure:"connection"`
 	NotificationCommon `yaml:",inline" json:",inline" mapstructure:",inline"`
 }
 
@@ -414,13 +424,20 @@ func (m Materialization) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// ColumnReference points to a column of another asset, e.g. the primary key a foreign key refers to.
+type ColumnReference struct {
+	Asset  string `json:"asset" yaml:"asset" mapstructure:"asset"`
+	Column string `json:"column" yaml:"column" mapstructure:"column"`
+}
+
 type ColumnCheckValue struct {
-	IntArray    *[]int    `json:"int_array"`
-	Int         *int      `json:"int"`
-	Float       *float64  `json:"float"`
-	StringArray *[]string `json:"string_array"`
-	String      *string   `json:"string"`
-	Bool        *bool     `json:"bool"`
+	IntArray    *[]int           `json:"int_array"`
+	Int         *int             `json:"int"`
+	Float       *float64         `json:"float"`
+	StringArray *[]string        `json:"string_array"`
+	String      *string          `json:"string"`
+	Bool        *bool            `json:"bool"`
+	Reference   *ColumnReference `json:"reference"`
 }
 
 func (ccv *ColumnCheckValue) MarshalJSON() ([]byte, error) {
@@ -444,6 +461,9 @@ func (ccv *ColumnCheckValue) MarshalJSON() ([]byte, error) {
 	if actual.Bool != nil {
 		return json.Marshal(actual.Bool)
 	}
+	if actual.Reference != nil {
+		return json.Marshal(actual.Reference)
+	}
 
 	return []byte("null"), nil
 }
@@ -467,6 +487,9 @@ func (ccv ColumnCheckValue) MarshalYAML() (interface{}, error) {
 	if ccv.Bool != nil {
 		return ccv.Bool, nil
 	}
+	if ccv.Reference != nil {
+		return ccv.Reference, nil
+	}
 	return nil, nil
 }
 
@@ -509,6 +532,14 @@ func (ccv *ColumnCheckValue) UnmarshalJSON(data []byte) error {
 		ccv.String = &v
 	case bool:
 		ccv.Bool = &v
+	case map[string]interface{}:
+		var ref ColumnReference
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&ref); err != nil {
+			return fmt.Errorf("unable to parse JSON structure %v into ColumnCheckValue", v)
+		}
+		ccv.Reference = &ref
 	default:
 		return fmt.Errorf("unexpected type %T", v)
 	}
@@ -539,6 +570,9 @@ func (ccv *ColumnCheckValue) ToString() string {
 	if ccv.Bool != nil {
 		return strconv.FormatBool(*ccv.Bool)
 	}
+	if ccv.Reference != nil {
+		return ccv.Reference.Asset + "." + ccv.Reference.Column
+	}
 
 	return ""
 }
@@ -549,6 +583,10 @@ type ColumnCheck struct {
 	Value         ColumnCheckValue `json:"value" yaml:"value,omitempty" mapstructure:"value"`
 	Blocking      DefaultTrueBool  `json:"blocking" yaml:"blocking,omitempty" mapstructure:"blocking"`
 	Description   string           `json:"description" yaml:"description,omitempty" mapstructure:"description"`
+	Severity      CheckSeverity    `json:"severity,omitempty" yaml:"severity,omitempty" mapstructure:"severity"`
+	WarnIf        string           `json:"warn_if,omitempty" yaml:"warn_if,omitempty" mapstructure:"warn_if"`
+	ErrorIf       string           `json:"error_if,omitempty" yaml:"error_if,omitempty" mapstructure:"error_if"`
+	Scope         CheckScope       `json:"scope,omitempty" yaml:"scope,omitempty" mapstructure:"scope"`
 	Notifications *Notifications   `json:"notifications,omitempty" yaml:"notifications,omitempty" mapstructure:"notifications"`
 }
 
@@ -682,6 +720,9 @@ var AssetTypeConnectionMapping = map[AssetType]string{
 	AssetTypeVerticaSource:             "vertica",
 	AssetTypeQuicksightDataset:         "quicksight",
 	AssetTypeQuicksightDashboard:       "quicksight",
+	AssetTypeLooker:                    "looker",
+	AssetTypePowerBI:                   "powerbi",
+	AssetTypeMetabase:                  "metabase",
 }
 
 var IngestrTypeConnectionMapping = map[string]AssetType{
@@ -730,10 +771,50 @@ type CustomCheck struct {
 	Value         int64           `json:"value" yaml:"value" mapstructure:"value"`
 	Count         *int64          `json:"count,omitempty" yaml:"count,omitempty" mapstructure:"count"`
 	Blocking      DefaultTrueBool `json:"blocking" yaml:"blocking,omitempty" mapstructure:"blocking"`
+	Severity      CheckSeverity   `json:"severity,omitempty" yaml:"severity,omitempty" mapstructure:"severity"`
+	WarnIf        string          `json:"warn_if,omitempty" yaml:"warn_if,omitempty" mapstructure:"warn_if"`
+	ErrorIf       string          `json:"error_if,omitempty" yaml:"error_if,omitempty" mapstructure:"error_if"`
+	Scope         CheckScope      `json:"scope,omitempty" yaml:"scope,omitempty" mapstructure:"scope"`
 	Query         string          `json:"query" yaml:"query" mapstructure:"query"`
 	Notifications *Notifications  `json:"notifications,omitempty" yaml:"notifications,omitempty" mapstructure:"notifications"`
 }
 
+const (
+	TableCheckRowCount       = "row_count"
+	TableCheckFreshness      = "freshness"
+	TableCheckRowCountChange = "row_count_change"
+)
+
+// TableCheck is a quality check that runs against the whole table of an asset instead of a single column. The
+// fields that are used depend on the check:
+//   - row_count: the number of rows must be within Min and Max, both are inclusive and optional.
+//   - freshness: the latest value of the timestamp Column must not be older than MaxAge, e.g. "6h" or "2d".
+//   - row_count_change: the number of rows must not change by more than MaxChange percent since the last run.
+type TableCheck struct {
+	ID            string          `json:"id" yaml:"-" mapstructure:"-"`
+	Name          string          `json:"name" yaml:"name" mapstructure:"name"`
+	Description   string          `json:"description" yaml:"description,omitempty" mapstructure:"description"`
+	Min           *int64          `json:"min,omitempty" yaml:"min,omitempty" mapstructure:"min"`
+	Max           *int64          `json:"max,omitempty" yaml:"max,omitempty" mapstructure:"max"`
+	Column        string          `json:"column,omitempty" yaml:"column,omitempty" mapstructure:"column"`
+	MaxAge        string          `json:"max_age,omitempty" yaml:"max_age,omitempty" mapstructure:"max_age"`
+	MaxChange     *float64        `json:"max_change,omitempty" yaml:"max_change,omitempty" mapstructure:"max_change"`
+	Blocking      DefaultTrueBool `json:"blocking" yaml:"blocking,omitempty" mapstructure:"blocking"`
+	Severity      CheckSeverity   `json:"severity,omitempty" yaml:"severity,omitempty" mapstructure:"severity"`
+	WarnIf        string          `json:"warn_if,omitempty" yaml:"warn_if,omitempty" mapstructure:"warn_if"`
+	ErrorIf       string          `json:"error_if,omitempty" yaml:"error_if,omitempty" mapstructure:"error_if"`
+	Notifications *Notifications  `json:"notifications,omitempty" yaml:"notifications,omitempty" mapstructure:"notifications"`
+}
+
+// HumanName identifies the check within its asset, freshness checks can be defined for multiple columns.
+func (c *TableCheck) HumanName() string {
+	if c.Column == "" {
+		return c.Name
+	}
+
+	return c.Name + ":" + c.Column
+}
+
 type DependsColumn struct {
 	Name  string `json:"name" yaml:"name" mapstructure:"name"`
 	Usage string `json:"usage" yaml:"usage" mapstructure:"usage"`
@@ -822,7 +903,9 @@ type Asset struct { //nolint:recvcheck
 	Extends           []string           `json:"extends" yaml:"extends,omitempty" mapstructure:"extends"`
 	Columns           []Column           `json:"columns" yaml:"columns,omitempty" mapstructure:"columns"`
 	CustomChecks      []CustomCheck      `json:"custom_checks" yaml:"custom_checks,omitempty" mapstructure:"custom_checks"`
+	TableChecks       []TableCheck       `json:"table_checks,omitempty" yaml:"table_checks,omitempty" mapstructure:"table_checks"`
 	Hooks             Hooks              `json:"hooks,omitempty" yaml:"hooks,omitempty" mapstructure:"hooks"`
+	Tests             []UnitTest         `json:"tests,omitempty" yaml:"tests,omitempty" mapstructure:"tests"`
 	Metadata          EmptyStringMap     `json:"metadata" yaml:"metadata,omitempty" mapstructure:"metadata"`
 	Snowflake         SnowflakeConfig    `json:"snowflake" yaml:"snowflake,omitempty" mapstructure:"snowflake"`
 	Athena            AthenaConfig       `json:"athena" yaml:"athena,omitempty" mapstructure:"athena"`
@@ -992,30 +1075,103 @@ func (a *Asset) AddUpstream(asset *Asset) {
 	})
 }
 
-func (a *Asset) PrefixSchema(prefix string) {
-	if prefix == "" {
-		return
+func (a *Asset) hasUpstream(name string) bool {
+	for _, u := range a.Upstreams {
+		if strings.EqualFold(u.Value, name) {
+			return true
+		}
 	}
 
-	nameParts := strings.Split(a.Name, ".")
-	if len(nameParts) == 2 {
-		a.Name = prefix + nameParts[0] + "." + nameParts[1]
+	return false
+}
+
+// referencedAssetsInChecks returns the other assets the column checks of the asset refer to.
+func (a *Asset) referencedAssetsInChecks() []string {
+	var names []string
+	for _, col := range a.Columns {
+		for _, check := range col.Checks {
+			if check.Value.Reference == nil || check.Value.Reference.Asset == "" {
+				continue
+			}
+			if !slices.Contains(names, check.Value.Reference.Asset) {
+				names = append(names, check.Value.Reference.Asset)
+			}
+		}
 	}
+
+	return names
 }
 
-func (a *Asset) PrefixUpstreams(prefix string) {
+// referencedAssetsInQuery returns the assets the query of the asset refers to with ref() calls. Only the calls with a
+// literal name are found here, the ones with a computed name are resolved when the query is rendered.
+func (a *Asset) referencedAssetsInQuery() []string {
+	if !strings.HasSuffix(a.ExecutableFile.Path, ".sql") {
+		return nil
+	}
+
+	var names []string
+	for _, match := range refCallRegex.FindAllStringSubmatch(a.ExecutableFile.Content, -1) {
+		name := strings.TrimSpace(match[1])
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// addUpstreamsFromRefs registers the assets referenced with ref() as upstreams, so that they don't have to be
+// repeated under depends.
+func (a *Asset) addUpstreamsFromRefs() {
+	for _, name := range a.referencedAssetsInQuery() {
+		if strings.EqualFold(name, a.Name) || a.hasUpstream(name) {
+			continue
+		}
+
+		a.Upstreams = append(a.Upstreams, Upstream{Type: "asset", Value: name, Columns: make([]DependsColumn, 0), Mode: UpstreamModeFull})
+	}
+}
+
+// PrefixSchemaName adds the schema prefix of a developer environment to the name of an asset, only the names made of a
+// schema and a table are prefixed.
+func PrefixSchemaName(name, prefix string) string {
 	if prefix == "" {
-		return
+		return name
+	}
+
+	nameParts := strings.Split(name, ".")
+	if len(nameParts) != 2 {
+		return name
 	}
 
+	return prefix + nameParts[0] + "." + nameParts[1]
+}
+
+func (a *Asset) PrefixSchema(prefix string) {
+	a.Name = PrefixSchemaName(a.Name, prefix)
+}
+
+func (a *Asset) PrefixUpstreams(prefix string) {
 	for i, u := range a.Upstreams {
 		if u.Type != "asset" {
 			continue
 		}
 
-		nameParts := strings.Split(u.Value, ".")
-		if len(nameParts) == 2 {
-			a.Upstreams[i].Value = prefix + nameParts[0] + "." + nameParts[1]
+		a.Upstreams[i].Value = PrefixSchemaName(u.Value, prefix)
+	}
+}
+
+// PrefixCheckReferences prefixes the assets referenced by the relationships checks, so that the checks compare against
+// the tables of the developer environment and the referenced assets are still found by their renamed names.
+func (a *Asset) PrefixCheckReferences(prefix string) {
+	for i := range a.Columns {
+		for j := range a.Columns[i].Checks {
+			ref := a.Columns[i].Checks[j].Value.Reference
+			if ref == nil || ref.Asset == "" {
+				continue
+			}
+
+			ref.Asset = PrefixSchemaName(ref.Asset, prefix)
 		}
 	}
 }
@@ -1117,6 +1273,10 @@ func (a *Asset) removeExtraSpacesAtLineEndingsInTextContent() {
 		a.CustomChecks[i].Description = ClearSpacesAtLineEndings(a.CustomChecks[i].Description)
 		a.CustomChecks[i].Query = ClearSpacesAtLineEndings(a.CustomChecks[i].Query)
 	}
+
+	for i := range a.TableChecks {
+		a.TableChecks[i].Description = ClearSpacesAtLineEndings(a.TableChecks[i].Description)
+	}
 	for i := range a.Hooks.Pre {
 		a.Hooks.Pre[i].Query = ClearSpacesAtLineEndings(a.Hooks.Pre[i].Query)
 	}
@@ -1204,6 +1364,7 @@ func (a *Asset) CheckCount() int {
 	}
 
 	checkCount += len(a.CustomChecks)
+	checkCount += len(a.TableChecks)
 	return checkCount
 }
 
@@ -1346,7 +1507,7 @@ func (a *Asset) FormatContent() ([]byte, error) {
 
 	yamlConfig := buf.Bytes()
 
-	keysToAddSpace := []string{"custom_checks", "depends", "columns", "materialization", "secrets", "parameters", "hooks"}
+	keysToAddSpace := []string{"custom_checks", "table_checks", "depends", "columns", "materialization", "secrets", "parameters", "hooks", "tests"}
 	for _, key := range keysToAddSpace {
 		yamlConfig = bytes.ReplaceAll(yamlConfig, []byte("\n"+key+":"), []byte("\n\n"+key+":"))
 	}
@@ -1669,6 +1830,28 @@ func (p *Pipeline) GetCompatibilityHash() string {
 	return hex.EncodeToString(hash.Sum(nil))
 }
 
+// GetCompatibilityHash returns a fingerprint of the asset definition: its dependencies, its executable content and
+// the settings that affect what it builds. It is used to detect the assets that changed between two runs.
+func (a *Asset) GetCompatibilityHash() string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s:%s{", a.Name, a.Type)
+	for _, upstream := range a.Upstreams {
+		fmt.Fprintf(hash, ":%s:%s:", upstream.Value, upstream.Type)
+	}
+	hash.Write([]byte("}"))
+	hash.Write([]byte(a.ExecutableFile.Content))
+
+	for _, part := range []any{a.Parameters, a.Materialization, a.Columns, a.CustomChecks, a.TableChecks} {
+		encoded, err := json.Marshal(part)
+		if err != nil {
+			continue
+		}
+		hash.Write(encoded)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 func (p *Pipeline) GetAllConnectionNamesForAsset(asset *Asset) ([]string, error) {
 	assetType := asset.Type
 	if assetType == AssetTypePython { //nolint
@@ -2143,6 +2326,9 @@ func (b *Builder) CreatePipelineFromPath(ctx context.Context, pathToPipeline str
 			continue
 		}
 
+		// registered before the mutators run so that the upstreams follow the renames of the developer environments
+		task.addUpstreamsFromRefs()
+
 		if config.isMutate {
 			task, err = b.MutateAsset(ctx, task, pipeline)
 			if err != nil {
@@ -2188,6 +2374,17 @@ func (b *Builder) CreatePipelineFromPath(ctx context.Context, pathToPipeline str
 			u.AddDownstream(asset)
 		}
 
+		// the assets referenced by relationships checks have to be built before the checks can run
+		for _, name := range asset.referencedAssetsInChecks() {
+			u, ok := pipeline.tasksByName[name]
+			if !ok || u == asset || asset.hasUpstream(name) {
+				continue
+			}
+
+			asset.AddUpstream(u)
+			u.AddDownstream(asset)
+		}
+
 		if len(entities) > 0 {
 			err := asset.EnrichFromEntityAttributes(entities)
 			if err != nil {
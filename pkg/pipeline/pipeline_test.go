@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -701,6 +702,14 @@ func TestColumnCheckValue_UnmarshalJSON(t *testing.T) {
 			want:       &pipeline.ColumnCheckValue{},
 			wantErr:    assert.NoError,
 		},
+		{
+			name:       "should unmarshal column reference",
+			jsonFields: map[string]interface{}{"asset": "raw.customers", "column": "id"},
+			want: &pipeline.ColumnCheckValue{
+				Reference: &pipeline.ColumnReference{Asset: "raw.customers", Column: "id"},
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name:       "should return error for invalid type",
 			jsonFields: map[string]interface{}{"invalid": "data"},
@@ -788,6 +797,14 @@ func TestColumnCheckValue_MarshalJSON(t *testing.T) {
 			want:    "false",
 			wantErr: assert.NoError,
 		},
+		{
+			name: "marshal column reference",
+			ccv: &pipeline.ColumnCheckValue{
+				Reference: &pipeline.ColumnReference{Asset: "raw.customers", Column: "id"},
+			},
+			want:    `{"asset":"raw.customers","column":"id"}`,
+			wantErr: assert.NoError,
+		},
 		{
 			name:    "marshal nil",
 			ccv:     &pipeline.ColumnCheckValue{},
@@ -806,6 +823,7 @@ func TestColumnCheckValue_MarshalJSON(t *testing.T) {
 				StringArray: tt.ccv.StringArray,
 				String:      tt.ccv.String,
 				Bool:        tt.ccv.Bool,
+				Reference:   tt.ccv.Reference,
 			}
 			got, err := ccv.MarshalJSON()
 			if !tt.wantErr(t, err) {
@@ -1444,6 +1462,36 @@ func TestPipeline_GetCompatibilityHash(t *testing.T) {
 	}
 }
 
+func TestAsset_GetCompatibilityHash(t *testing.T) {
+	t.Parallel()
+
+	newAsset := func() *pipeline.Asset {
+		return &pipeline.Asset{
+			Name:           "orders",
+			Type:           pipeline.AssetTypeBigqueryQuery,
+			ExecutableFile: pipeline.ExecutableFile{Content: "select * from raw.orders"},
+			Upstreams:      []pipeline.Upstream{{Type: "asset", Value: "raw.orders"}},
+			Columns:        []pipeline.Column{{Name: "id", Checks: []pipeline.ColumnCheck{{Name: "not_null"}}}},
+		}
+	}
+
+	base := newAsset().GetCompatibilityHash()
+	assert.Equal(t, base, newAsset().GetCompatibilityHash())
+
+	changes := map[string]func(a *pipeline.Asset){
+		"content":  func(a *pipeline.Asset) { a.ExecutableFile.Content = "select 1" },
+		"upstream": func(a *pipeline.Asset) { a.Upstreams = nil },
+		"check":    func(a *pipeline.Asset) { a.Columns[0].Checks = nil },
+		"strategy": func(a *pipeline.Asset) { a.Materialization.Strategy = pipeline.MaterializationStrategyMerge },
+	}
+
+	for name, change := range changes {
+		asset := newAsset()
+		change(asset)
+		assert.NotEqual(t, base, asset.GetCompatibilityHash(), name)
+	}
+}
+
 func TestBuilder_SetAssetColumnFromGlossary(t *testing.T) {
 	t.Parallel()
 	t.Run("SetAssetColumnFromGlossary", func(t *testing.T) {
@@ -2455,3 +2503,106 @@ func TestAsset_FormatContent_DeduplicatesTags(t *testing.T) {
 		})
 	}
 }
+
+func TestBuilder_CreatePipelineFromPath_RelationshipsUpstream(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewCacheOnReadFs(afero.NewOsFs(), afero.NewMemMapFs(), 0)
+	config := pipeline.BuilderConfig{
+		PipelineFileName:    []string{"pipeline.yml"},
+		TasksDirectoryNames: []string{"assets"},
+		TasksFileSuffixes:   []string{"asset.yml"},
+	}
+	builder := pipeline.NewBuilder(config, pipeline.CreateTaskFromYamlDefinition(fs), pipeline.CreateTaskFromFileComments(fs), fs, nil)
+	p, err := builder.CreatePipelineFromPath(t.Context(), "./testdata/pipeline/relationships-pipeline")
+	require.NoError(t, err)
+
+	orders := p.GetAssetByName("mart.orders")
+	require.NotNil(t, orders)
+	require.Len(t, orders.Columns, 3)
+	assert.Equal(t, &pipeline.ColumnReference{Asset: "raw.customers", Column: "id"}, orders.Columns[0].Checks[0].Value.Reference)
+
+	// the referenced assets within the pipeline run first, the ones outside of it are left alone
+	customers := p.GetAssetByName("raw.customers")
+	products := p.GetAssetByName("raw.products")
+	assert.ElementsMatch(t, []*pipeline.Asset{customers, products}, orders.GetUpstream())
+	assert.ElementsMatch(t, []*pipeline.Asset{orders}, customers.GetDownstream())
+	assert.ElementsMatch(t, []*pipeline.Asset{orders}, products.GetDownstream())
+	assert.Equal(t, []pipeline.Upstream{
+		{Type: "asset", Value: "raw.products", Columns: []pipeline.DependsColumn{}, Mode: pipeline.UpstreamModeFull},
+		{Type: "asset", Value: "raw.customers", Mode: pipeline.UpstreamModeFull},
+	}, orders.Upstreams)
+}
+
+func TestBuilder_CreatePipelineFromPath_RelationshipsUpstreamWithSchemaPrefix(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewCacheOnReadFs(afero.NewOsFs(), afero.NewMemMapFs(), 0)
+	config := pipeline.BuilderConfig{
+		PipelineFileName:    []string{"pipeline.yml"},
+		TasksDirectoryNames: []string{"assets"},
+		TasksFileSuffixes:   []string{"asset.yml"},
+	}
+	builder := pipeline.NewBuilder(config, pipeline.CreateTaskFromYamlDefinition(fs), pipeline.CreateTaskFromFileComments(fs), fs, nil)
+	builder.AddAssetMutator(func(ctx context.Context, asset *pipeline.Asset, foundPipeline *pipeline.Pipeline) (*pipeline.Asset, error) {
+		asset.PrefixSchema("dev_")
+		asset.PrefixUpstreams("dev_")
+		asset.PrefixCheckReferences("dev_")
+		return asset, nil
+	})
+	p, err := builder.CreatePipelineFromPath(t.Context(), "./testdata/pipeline/relationships-pipeline", pipeline.WithMutate())
+	require.NoError(t, err)
+
+	orders := p.GetAssetByName("dev_mart.orders")
+	require.NotNil(t, orders)
+	assert.Equal(t, &pipeline.ColumnReference{Asset: "dev_raw.customers", Column: "id"}, orders.Columns[0].Checks[0].Value.Reference)
+
+	customers := p.GetAssetByName("dev_raw.customers")
+	products := p.GetAssetByName("dev_raw.products")
+	require.NotNil(t, customers)
+	assert.ElementsMatch(t, []*pipeline.Asset{customers, products}, orders.GetUpstream())
+	assert.ElementsMatch(t, []*pipeline.Asset{orders}, customers.GetDownstream())
+}
+
+func TestBuilder_CreatePipelineFromPath_RefUpstreams(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewCacheOnReadFs(afero.NewOsFs(), afero.NewMemMapFs(), 0)
+	config := pipeline.BuilderConfig{
+		PipelineFileName:    []string{"pipeline.yml"},
+		TasksDirectoryNames: []string{"assets"},
+		TasksFileSuffixes:   []string{"asset.yml"},
+	}
+	builder := pipeline.NewBuilder(config, pipeline.CreateTaskFromYamlDefinition(fs), pipeline.CreateTaskFromFileComments(fs), fs, nil)
+	builder.AddAssetMutator(func(ctx context.Context, asset *pipeline.Asset, foundPipeline *pipeline.Pipeline) (*pipeline.Asset, error) {
+		asset.PrefixSchema("dev_")
+		asset.PrefixUpstreams("dev_")
+		return asset, nil
+	})
+	p, err := builder.CreatePipelineFromPath(t.Context(), "./testdata/pipeline/refs-pipeline", pipeline.WithMutate())
+	require.NoError(t, err)
+
+	revenue := p.GetAssetByName("dev_mart.revenue")
+	require.NotNil(t, revenue)
+
+	// the ref() upstreams follow the renames of the mutators like the ones under depends, the sources are not upstreams
+	customers := p.GetAssetByName("dev_raw.customers")
+	orders := p.GetAssetByName("dev_raw.orders")
+	assert.ElementsMatch(t, []*pipeline.Asset{customers, orders}, revenue.GetUpstream())
+	assert.Equal(t, []pipeline.Upstream{
+		{Type: "asset", Value: "dev_raw.orders", Columns: []pipeline.DependsColumn{}, Mode: pipeline.UpstreamModeFull},
+		{Type: "asset", Value: "dev_raw.customers", Columns: []pipeline.DependsColumn{}, Mode: pipeline.UpstreamModeFull},
+		{Type: "asset", Value: "dev_external.refunds", Columns: []pipeline.DependsColumn{}, Mode: pipeline.UpstreamModeFull},
+	}, revenue.Upstreams)
+	assert.Empty(t, customers.Upstreams)
+	assert.Empty(t, orders.Upstreams)
+}
+
+func TestPrefixSchemaName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "dev_raw.orders", pipeline.PrefixSchemaName("raw.orders", "dev_"))
+	assert.Equal(t, "raw.orders", pipeline.PrefixSchemaName("raw.orders", ""))
+	assert.Equal(t, "project.raw.orders", pipeline.PrefixSchemaName("project.raw.orders", "dev_"))
+	assert.Equal(t, "orders", pipeline.PrefixSchemaName("orders", "dev_"))
+}
@@ -0,0 +1,75 @@
+package pipeline
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// Interval is a single schedule interval, both ends are inclusive in line with the `end_date` semantics of `bruin run`.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// IsContinuous reports whether the schedule describes a continuously running pipeline without discrete intervals.
+func (s Schedule) IsContinuous() bool {
+	return s == "continuous" || s == "@continuous"
+}
+
+// CronSchedule parses the schedule into a cron schedule, accepting the `daily`, `hourly`, `weekly` and `monthly` shorthands.
+func (s Schedule) CronSchedule() (cron.Schedule, error) {
+	if s == "" {
+		return nil, errors.New("the pipeline does not have a schedule")
+	}
+
+	if s.IsContinuous() {
+		return nil, errors.New("continuous schedules do not have intervals")
+	}
+
+	expression := string(s)
+	switch expression {
+	case "daily", "hourly", "weekly", "monthly":
+		expression = "@" + expression
+	}
+
+	parsed, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cron schedule '%s'", s)
+	}
+
+	return parsed, nil
+}
+
+// Intervals splits the given range into the complete schedule intervals that fall within it.
+// The first interval starts at the first schedule tick at or after `start`, partial intervals at either end are dropped.
+func (s Schedule) Intervals(start, end time.Time) ([]Interval, error) {
+	schedule, err := s.CronSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	if start.After(end) {
+		return nil, errors.Errorf("start date %s is after end date %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	intervals := make([]Interval, 0)
+	current := schedule.Next(start.Add(-time.Nanosecond))
+	for {
+		next := schedule.Next(current)
+		if next.IsZero() {
+			break
+		}
+
+		intervalEnd := next.Add(-time.Microsecond)
+		if intervalEnd.After(end) {
+			break
+		}
+
+		intervals = append(intervals, Interval{Start: current, End: intervalEnd})
+		current = next
+	}
+
+	return intervals, nil
+}
@@ -0,0 +1,120 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse("2006-01-02 15:04:05.999999", value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestSchedule_Intervals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		schedule pipeline.Schedule
+		start    string
+		end      string
+		want     [][2]string
+		wantErr  string
+	}{
+		{
+			name:     "daily shorthand over three full days",
+			schedule: "daily",
+			start:    "2024-01-01 00:00:00",
+			end:      "2024-01-03 23:59:59.999999",
+			want: [][2]string{
+				{"2024-01-01 00:00:00", "2024-01-01 23:59:59.999999"},
+				{"2024-01-02 00:00:00", "2024-01-02 23:59:59.999999"},
+				{"2024-01-03 00:00:00", "2024-01-03 23:59:59.999999"},
+			},
+		},
+		{
+			name:     "end at midnight excludes the incomplete last day",
+			schedule: "@daily",
+			start:    "2024-01-01 00:00:00",
+			end:      "2024-01-03 00:00:00",
+			want: [][2]string{
+				{"2024-01-01 00:00:00", "2024-01-01 23:59:59.999999"},
+				{"2024-01-02 00:00:00", "2024-01-02 23:59:59.999999"},
+			},
+		},
+		{
+			name:     "unaligned start is moved to the next tick",
+			schedule: "0 */6 * * *",
+			start:    "2024-01-01 01:30:00",
+			end:      "2024-01-01 17:59:59.999999",
+			want: [][2]string{
+				{"2024-01-01 06:00:00", "2024-01-01 11:59:59.999999"},
+				{"2024-01-01 12:00:00", "2024-01-01 17:59:59.999999"},
+			},
+		},
+		{
+			name:     "range shorter than an interval yields nothing",
+			schedule: "monthly",
+			start:    "2024-01-01 00:00:00",
+			end:      "2024-01-15 00:00:00",
+			want:     [][2]string{},
+		},
+		{
+			name:     "empty schedule",
+			schedule: "",
+			start:    "2024-01-01 00:00:00",
+			end:      "2024-01-02 00:00:00",
+			wantErr:  "the pipeline does not have a schedule",
+		},
+		{
+			name:     "continuous schedule",
+			schedule: "continuous",
+			start:    "2024-01-01 00:00:00",
+			end:      "2024-01-02 00:00:00",
+			wantErr:  "continuous schedules do not have intervals",
+		},
+		{
+			name:     "invalid cron",
+			schedule: "every day",
+			start:    "2024-01-01 00:00:00",
+			end:      "2024-01-02 00:00:00",
+			wantErr:  "invalid cron schedule 'every day'",
+		},
+		{
+			name:     "start after end",
+			schedule: "daily",
+			start:    "2024-01-03 00:00:00",
+			end:      "2024-01-02 00:00:00",
+			wantErr:  "is after end date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.schedule.Intervals(mustTime(t, tt.start), mustTime(t, tt.end))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			gotStrings := make([][2]string, 0, len(got))
+			for _, interval := range got {
+				gotStrings = append(gotStrings, [2]string{
+					interval.Start.Format("2006-01-02 15:04:05.999999"),
+					interval.End.Format("2006-01-02 15:04:05.999999"),
+				})
+			}
+			assert.Equal(t, tt.want, gotStrings)
+		})
+	}
+}
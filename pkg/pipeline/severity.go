@@ -0,0 +1,119 @@
+package pipeline
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// CheckSeverity tells how a failing quality check is reported: errors fail the run, warnings are reported but let the
+// run succeed.
+type CheckSeverity string
+
+const (
+	CheckSeverityError CheckSeverity = "error"
+	CheckSeverityWarn  CheckSeverity = "warn"
+)
+
+func (s CheckSeverity) IsValid() bool {
+	return s == "" || s == CheckSeverityError || s == CheckSeverityWarn
+}
+
+var checkThresholdRegex = regexp.MustCompile(`^\s*(>=|<=|!=|==|=|>|<)\s*(-?\d+)\s*$`)
+
+// CheckThreshold is a condition on the result of a check such as ">10" or "!=0", as used by `warn_if` and `error_if`.
+type CheckThreshold struct {
+	Operator string
+	Value    int64
+}
+
+func ParseCheckThreshold(s string) (*CheckThreshold, error) {
+	match := checkThresholdRegex.FindStringSubmatch(s)
+	if match == nil {
+		return nil, fmt.Errorf("invalid threshold '%s', it must be a comparison such as '>10' or '!=0'", s)
+	}
+
+	value, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid threshold '%s': %w", s, err)
+	}
+
+	operator := match[1]
+	if operator == "==" {
+		operator = "="
+	}
+
+	return &CheckThreshold{Operator: operator, Value: value}, nil
+}
+
+func (t *CheckThreshold) Matches(result int64) bool {
+	switch t.Operator {
+	case ">":
+		return result > t.Value
+	case ">=":
+		return result >= t.Value
+	case "<":
+		return result < t.Value
+	case "<=":
+		return result <= t.Value
+	case "!=":
+		return result != t.Value
+	default:
+		return result == t.Value
+	}
+}
+
+func (t *CheckThreshold) String() string {
+	return fmt.Sprintf("%s%d", t.Operator, t.Value)
+}
+
+// ResultSeverity decides how the result of a check is reported, an empty severity means the check passed.
+//
+// Both thresholds default to the result differing from the expected value. With the "error" severity, the result is
+// an error when it matches `error_if` and a warning when it only matches `warn_if`. With the "warn" severity
+// `error_if` is ignored and the check can only warn.
+func ResultSeverity(severity CheckSeverity, warnIf, errorIf string, result, expected int64) (CheckSeverity, error) {
+	return ConditionSeverity(severity, warnIf, errorIf, result, result != expected)
+}
+
+// ConditionSeverity is ResultSeverity for checks that decide on their own whether they failed, e.g. a row count
+// outside of its bounds. Both thresholds default to the failure of the check and are compared against its result.
+func ConditionSeverity(severity CheckSeverity, warnIf, errorIf string, result int64, failed bool) (CheckSeverity, error) {
+	if !severity.IsValid() {
+		return "", fmt.Errorf("invalid severity '%s', it must be either '%s' or '%s'", severity, CheckSeverityError, CheckSeverityWarn)
+	}
+
+	warn, err := parseOptionalThreshold(warnIf)
+	if err != nil {
+		return "", err
+	}
+	errorThreshold, err := parseOptionalThreshold(errorIf)
+	if err != nil {
+		return "", err
+	}
+
+	matches := func(t *CheckThreshold) bool {
+		if t == nil {
+			return failed
+		}
+
+		return t.Matches(result)
+	}
+
+	if severity != CheckSeverityWarn && matches(errorThreshold) {
+		return CheckSeverityError, nil
+	}
+	if matches(warn) {
+		return CheckSeverityWarn, nil
+	}
+
+	return "", nil
+}
+
+func parseOptionalThreshold(threshold string) (*CheckThreshold, error) {
+	if threshold == "" {
+		return nil, nil
+	}
+
+	return ParseCheckThreshold(threshold)
+}
@@ -0,0 +1,81 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCheckThreshold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    *pipeline.CheckThreshold
+		wantErr bool
+	}{
+		{input: ">10", want: &pipeline.CheckThreshold{Operator: ">", Value: 10}},
+		{input: " >= 5 ", want: &pipeline.CheckThreshold{Operator: ">=", Value: 5}},
+		{input: "<-1", want: &pipeline.CheckThreshold{Operator: "<", Value: -1}},
+		{input: "==0", want: &pipeline.CheckThreshold{Operator: "=", Value: 0}},
+		{input: "!=0", want: &pipeline.CheckThreshold{Operator: "!=", Value: 0}},
+		{input: "10", wantErr: true},
+		{input: ">1.5", wantErr: true},
+		{input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := pipeline.ParseCheckThreshold(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResultSeverity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		severity pipeline.CheckSeverity
+		warnIf   string
+		errorIf  string
+		result   int64
+		want     pipeline.CheckSeverity
+		wantErr  bool
+	}{
+		{name: "passes by default", result: 0},
+		{name: "errors by default", result: 3, want: pipeline.CheckSeverityError},
+		{name: "warn severity warns", severity: pipeline.CheckSeverityWarn, result: 3, want: pipeline.CheckSeverityWarn},
+		{name: "warn severity ignores error_if", severity: pipeline.CheckSeverityWarn, errorIf: ">0", result: 3, want: pipeline.CheckSeverityWarn},
+		{name: "below error_if warns", errorIf: ">10", result: 3, want: pipeline.CheckSeverityWarn},
+		{name: "above error_if errors", errorIf: ">10", result: 11, want: pipeline.CheckSeverityError},
+		{name: "below both thresholds passes", warnIf: ">5", errorIf: ">10", result: 3},
+		{name: "between the thresholds warns", warnIf: ">5", errorIf: ">10", result: 7, want: pipeline.CheckSeverityWarn},
+		{name: "invalid severity", severity: "fatal", result: 3, wantErr: true},
+		{name: "invalid threshold", warnIf: "many", result: 3, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := pipeline.ResultSeverity(tt.severity, tt.warnIf, tt.errorIf, tt.result, 0)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
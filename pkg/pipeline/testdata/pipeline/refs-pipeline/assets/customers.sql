@@ -0,0 +1,6 @@
+/* @bruin
+name: raw.customers
+type: duckdb.sql
+@bruin */
+
+SELECT * FROM {{ source('landing', 'customers') }}
@@ -0,0 +1,6 @@
+/* @bruin
+name: raw.orders
+type: duckdb.sql
+@bruin */
+
+SELECT * FROM {{ source('landing.orders') }}
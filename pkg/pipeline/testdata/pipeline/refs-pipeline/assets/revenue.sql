@@ -0,0 +1,14 @@
+/* @bruin
+name: mart.revenue
+type: duckdb.sql
+depends:
+  - raw.orders
+@bruin */
+
+{% set customers = ref("raw.customers") %}
+
+SELECT c.name, SUM(o.amount) AS revenue
+FROM {{ ref('raw.orders') }} o
+JOIN {{ customers }} c ON c.id = o.customer_id
+LEFT JOIN {{ ref('external.refunds') }} r ON r.order_id = o.id
+GROUP BY c.name
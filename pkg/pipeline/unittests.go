@@ -0,0 +1,79 @@
+package pipeline
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// UnitTest tests the logic of the query of an asset without the warehouse: the query runs against the fixture rows
+// given for its upstream tables, and its output must match the expected rows.
+type UnitTest struct {
+	Name        string            `json:"name" yaml:"name" mapstructure:"name"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty" mapstructure:"description"`
+	Given       []UnitTestFixture `json:"given" yaml:"given" mapstructure:"given"`
+	Expect      UnitTestRows      `json:"expect" yaml:"expect" mapstructure:"expect"`
+}
+
+// UnitTestFixture replaces an upstream table of the query with the given rows, the table must be referenced the same
+// way the query references it, e.g. "raw.orders".
+type UnitTestFixture struct {
+	Table string           `json:"table" yaml:"table" mapstructure:"table"`
+	Rows  []map[string]any `json:"rows,omitempty" yaml:"rows,omitempty" mapstructure:"rows"`
+	CSV   string           `json:"csv,omitempty" yaml:"csv,omitempty" mapstructure:"csv"`
+}
+
+// UnitTestRows are the rows either given inline or read from a CSV file with a header, the path of the file is
+// relative to the asset definition. No rows and no file means the query must not return any rows.
+type UnitTestRows struct {
+	Rows []map[string]any `json:"rows,omitempty" yaml:"rows,omitempty" mapstructure:"rows"`
+	CSV  string           `json:"csv,omitempty" yaml:"csv,omitempty" mapstructure:"csv"`
+}
+
+func (r UnitTestRows) IsEmpty() bool {
+	return len(r.Rows) == 0 && r.CSV == ""
+}
+
+// CSVPath resolves the CSV file of the fixture or the expectation against the directory of the asset definition.
+func (a *Asset) CSVPath(csv string) string {
+	if csv == "" || filepath.IsAbs(csv) {
+		return csv
+	}
+
+	return filepath.Join(filepath.Dir(a.DefinitionFile.Path), csv)
+}
+
+// Validate checks the structure of the test, the fixture tables and the files are not looked up here.
+func (t *UnitTest) Validate() error {
+	if t.Name == "" {
+		return errors.New("unit tests must have a name")
+	}
+
+	if len(t.Given) == 0 {
+		return errors.Errorf("unit test '%s' must give the rows of at least one upstream table", t.Name)
+	}
+
+	seen := make(map[string]bool, len(t.Given))
+	for _, fixture := range t.Given {
+		if fixture.Table == "" {
+			return errors.Errorf("unit test '%s' has a fixture without a table", t.Name)
+		}
+		if seen[fixture.Table] {
+			return errors.Errorf("unit test '%s' gives the rows of table '%s' more than once", t.Name, fixture.Table)
+		}
+		seen[fixture.Table] = true
+
+		if len(fixture.Rows) > 0 && fixture.CSV != "" {
+			return errors.Errorf("unit test '%s' must give either the rows or a csv file for table '%s', not both", t.Name, fixture.Table)
+		}
+		if len(fixture.Rows) == 0 && fixture.CSV == "" {
+			return errors.Errorf("unit test '%s' must give the rows or a csv file for table '%s'", t.Name, fixture.Table)
+		}
+	}
+
+	if len(t.Expect.Rows) > 0 && t.Expect.CSV != "" {
+		return errors.Errorf("unit test '%s' must expect either the rows or a csv file, not both", t.Name)
+	}
+
+	return nil
+}
@@ -1,42 +1,110 @@
 package pipeline
 
 import (
+	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/xeipuuv/gojsonschema"
 )
 
-func varSchemaLoader() *gojsonschema.SchemaLoader { //nolint:unused
+// draft07MetaSchema is a local copy of the JSON Schema draft-07 meta-schema, so that the variable schemas can be
+// validated without fetching it over the network.
+//
+//go:embed draft-07-schema.json
+var draft07MetaSchema string
+
+func varSchemaLoader() (*gojsonschema.SchemaLoader, error) {
 	loader := gojsonschema.NewSchemaLoader()
 	loader.Draft = gojsonschema.Draft7
+	// the meta-schema is registered under its `$id`, which is what the `$schema` of the variables schema points to
+	if err := loader.AddSchemas(gojsonschema.NewStringLoader(draft07MetaSchema)); err != nil {
+		return nil, fmt.Errorf("failed to load the JSON Schema meta-schema: %w", err)
+	}
 	loader.Validate = true
-	return loader
+	return loader, nil
 }
 
 type Variables map[string]map[string]any
 
+// Validate ensures that every variable has a default, that the variables are valid JSON Schema definitions and that
+// the defaults satisfy them.
 func (v *Variables) Validate() error {
-	// TODO(turtledev):
-	// - validate the defaults actually satisfy the schema
-	// - make "properties" a required field for object types
-	//
-	// BUG: Schema compiler fetches the schema from the spec URL, which may break
-	// in environments where internet access is restricted.
-
-	// TODO: Use a local copy of the schema.
-	// _, err := varSchemaLoader().Compile(gojsonschema.NewGoLoader(v.Schema()))
-	// if err != nil {
-	// 	return fmt.Errorf("invalid variables schema: %w", err)
-	// }
+	// TODO(turtledev): make "properties" a required field for object types
 	for key, value := range *v {
 		if _, ok := value["default"]; !ok {
 			return fmt.Errorf("invalid variable %q: must have a default value", key)
 		}
 	}
+
+	schema, err := v.compile()
+	if err != nil {
+		return err
+	}
+
+	if err := validateVariableValues(schema, v.Value()); err != nil {
+		return fmt.Errorf("invalid variable defaults: %w", err)
+	}
+
 	return nil
 }
 
+// ValidateValues checks the given values, e.g. `--var` overrides or the values of a variant, against the schemas of
+// the variables they are assigned to.
+func (v *Variables) ValidateValues(values map[string]any) error {
+	subset := make(Variables, len(values))
+	for key := range values {
+		definition, ok := (*v)[key]
+		if !ok {
+			return fmt.Errorf("no such variable %q", key)
+		}
+		subset[key] = definition
+	}
+
+	schema, err := subset.compile()
+	if err != nil {
+		return err
+	}
+
+	return validateVariableValues(schema, values)
+}
+
+func (v *Variables) compile() (*gojsonschema.Schema, error) {
+	loader, err := varSchemaLoader()
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := loader.Compile(gojsonschema.NewGoLoader(v.Schema()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid variables schema: %w", err)
+	}
+
+	return schema, nil
+}
+
+func validateVariableValues(schema *gojsonschema.Schema, values map[string]any) error {
+	result, err := schema.Validate(gojsonschema.NewGoLoader(values))
+	if err != nil {
+		return fmt.Errorf("failed to validate the variables: %w", err)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	messages := make([]string, 0, len(result.Errors()))
+	for _, resultErr := range result.Errors() {
+		messages = append(messages, fmt.Sprintf("variable %q: %s", resultErr.Field(), resultErr.Description()))
+	}
+	sort.Strings(messages)
+
+	return errors.New(strings.Join(messages, "; "))
+}
+
 func (v *Variables) Value() map[string]any {
 	values := make(map[string]any)
 	for key, value := range *v {
@@ -63,7 +131,7 @@ func (v *Variables) SchemaMap() map[string]any {
 
 func (v *Variables) Schema() any {
 	return map[string]any{
-		"$schema":    "https://json-schema.org/draft-07/schema",
+		"$schema":    "http://json-schema.org/draft-07/schema#",
 		"type":       "object",
 		"properties": *v,
 	}
@@ -12,18 +12,37 @@ import (
 func TestVariables(t *testing.T) {
 	t.Parallel()
 
-	// TODO: restore this test when meta-schema validation is implemented
-	// t.Run("Should return an error if the variables are not valid JSONSchema object", func(t *testing.T) {
-	// 	t.Parallel()
-	// 	vars := pipeline.Variables{
-	// 		"user": {
-	// 			"type": "complex",
-	// 		},
-	// 	}
-	// 	err := vars.Validate()
-	// 	require.Error(t, err)
-	// 	assert.Contains(t, err.Error(), "invalid variables schema")
-	// })
+	t.Run("Should return an error if the variables are not valid JSONSchema object", func(t *testing.T) {
+		t.Parallel()
+		vars := pipeline.Variables{
+			"user": {
+				"type":    "complex",
+				"default": "foo",
+			},
+		}
+		err := vars.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid variables schema")
+	})
+	t.Run("Should return an error if the default does not match the type", func(t *testing.T) {
+		t.Parallel()
+		vars := pipeline.Variables{
+			"age": {
+				"type":    "integer",
+				"default": "forty-two",
+			},
+			"env": {
+				"type":    "string",
+				"enum":    []any{"dev", "prod"},
+				"default": "dev",
+			},
+		}
+		err := vars.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid variable defaults")
+		assert.Contains(t, err.Error(), `variable "age": Invalid type. Expected: integer, given: string`)
+		assert.NotContains(t, err.Error(), "env")
+	})
 	t.Run("Should return an error if the default is not set", func(t *testing.T) {
 		t.Parallel()
 		vars := pipeline.Variables{
@@ -107,6 +126,76 @@ func TestVariables(t *testing.T) {
 	})
 }
 
+func TestVariables_ValidateValues(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.Variables{
+		"env": {
+			"type":    "string",
+			"enum":    []any{"dev", "prod"},
+			"default": "dev",
+		},
+		"limit": {
+			"type":    "integer",
+			"minimum": 1,
+			"default": 10,
+		},
+		"users": {
+			"type":    "array",
+			"items":   map[string]any{"type": "string"},
+			"default": []any{"alice"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]any
+		wantErr []string
+	}{
+		{
+			name:   "valid values",
+			values: map[string]any{"env": "prod", "limit": int64(5), "users": []any{"bob", "carol"}},
+		},
+		{
+			name:    "value outside of the enum",
+			values:  map[string]any{"env": "staging"},
+			wantErr: []string{`variable "env"`},
+		},
+		{
+			name:    "wrong types",
+			values:  map[string]any{"limit": "5", "users": []any{1}},
+			wantErr: []string{`variable "limit": Invalid type. Expected: integer, given: string`, `variable "users.0"`},
+		},
+		{
+			name:    "value below the minimum",
+			values:  map[string]any{"limit": 0},
+			wantErr: []string{`variable "limit"`},
+		},
+		{
+			name:    "unknown variable",
+			values:  map[string]any{"region": "eu"},
+			wantErr: []string{`no such variable "region"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := vars.ValidateValues(tt.values)
+			if len(tt.wantErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
 func TestVariables_SchemaMap(t *testing.T) {
 	t.Parallel()
 
@@ -364,6 +364,9 @@ func renderAssetStrings(render RenderFunc, a *Asset) error {
 			if cc.Description, err = maybeRender(render, fmt.Sprintf("asset[%s].columns[%d].checks[%d].description", originalName, i, j), cc.Description); err != nil {
 				return err
 			}
+			if err = renderCheckSeverity(render, fmt.Sprintf("asset[%s].columns[%d].checks[%d]", originalName, i, j), &cc.Severity, &cc.WarnIf, &cc.ErrorIf); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -375,7 +378,41 @@ func renderAssetStrings(render RenderFunc, a *Asset) error {
 		if cc.Description, err = maybeRender(render, fmt.Sprintf("asset[%s].custom_checks[%d].description", originalName, i), cc.Description); err != nil {
 			return err
 		}
+		if err = renderCheckSeverity(render, fmt.Sprintf("asset[%s].custom_checks[%d]", originalName, i), &cc.Severity, &cc.WarnIf, &cc.ErrorIf); err != nil {
+			return err
+		}
+	}
+
+	for i := range a.TableChecks {
+		tc := &a.TableChecks[i]
+		if tc.Description, err = maybeRender(render, fmt.Sprintf("asset[%s].table_checks[%d].description", originalName, i), tc.Description); err != nil {
+			return err
+		}
+		if tc.Column, err = maybeRender(render, fmt.Sprintf("asset[%s].table_checks[%d].column", originalName, i), tc.Column); err != nil {
+			return err
+		}
+		if tc.MaxAge, err = maybeRender(render, fmt.Sprintf("asset[%s].table_checks[%d].max_age", originalName, i), tc.MaxAge); err != nil {
+			return err
+		}
+		if err = renderCheckSeverity(render, fmt.Sprintf("asset[%s].table_checks[%d]", originalName, i), &tc.Severity, &tc.WarnIf, &tc.ErrorIf); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+func renderCheckSeverity(render RenderFunc, path string, severity *CheckSeverity, warnIf, errorIf *string) error {
+	rendered, err := maybeRender(render, path+".severity", string(*severity))
+	if err != nil {
+		return err
+	}
+	*severity = CheckSeverity(rendered)
+
+	if *warnIf, err = maybeRender(render, path+".warn_if", *warnIf); err != nil {
+		return err
+	}
+	*errorIf, err = maybeRender(render, path+".error_if", *errorIf)
+
+	return err
+}
@@ -289,8 +289,12 @@ func TestVariantVisitorCoversStringFields(t *testing.T) {
 		"Pipeline.Assets[].Columns[].Upstreams[].Column":        true,
 		"Pipeline.Assets[].Columns[].Upstreams[].Table":         true,
 		"Pipeline.Assets[].Columns[].Checks[].ID":               true,
+		"Pipeline.Assets[].Columns[].Checks[].Scope":            true, // table or interval
 		"Pipeline.Assets[].CustomChecks[].ID":                   true,
+		"Pipeline.Assets[].CustomChecks[].Scope":                true,
 		"Pipeline.Assets[].CustomChecks[].Query":                true,
+		"Pipeline.Assets[].TableChecks[].ID":                    true,
+		"Pipeline.Assets[].TableChecks[].Name":                  true, // the check type, e.g. row_count
 		"Pipeline.DefaultValues.Hooks.Pre[].Query":              true,
 		"Pipeline.DefaultValues.Hooks.Post[].Query":             true,
 
@@ -363,6 +367,7 @@ func buildFullyPopulatedPipelineForVisitorTest() *pipeline.Pipeline {
 			},
 		},
 		CustomChecks: []pipeline.CustomCheck{{Name: "cc", Query: "q"}},
+		TableChecks:  []pipeline.TableCheck{{Name: "freshness", Column: "c", MaxAge: "1d"}},
 		Hooks: pipeline.Hooks{
 			Pre:  []pipeline.Hook{{Query: "q"}},
 			Post: []pipeline.Hook{{Query: "q"}},
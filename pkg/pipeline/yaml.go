@@ -23,6 +23,7 @@ var ValidQualityChecks = map[string]bool{
 	"negative":        true,
 	"non_negative":    true,
 	"pattern":         true,
+	"relationships":   true,
 }
 
 func mustBeStringArray(fieldName string, value *yaml.Node) ([]string, error) {
@@ -225,6 +226,7 @@ type columnCheckValue struct {
 	StringArray *[]string
 	String      *string
 	Bool        *bool
+	Reference   *ColumnReference
 }
 
 func (a *columnCheckValue) UnmarshalYAML(value *yaml.Node) error {
@@ -258,6 +260,12 @@ func (a *columnCheckValue) UnmarshalYAML(value *yaml.Node) error {
 		*a = columnCheckValue{Float: &v}
 	case bool:
 		*a = columnCheckValue{Bool: &v}
+	case map[string]interface{}:
+		var ref ColumnReference
+		if err := value.Decode(&ref); err != nil {
+			return &ParseError{Msg: err.Error()}
+		}
+		*a = columnCheckValue{Reference: &ref}
 	default:
 		return &ParseError{Msg: fmt.Sprintf("unexpected type %T", v)}
 	}
@@ -270,6 +278,10 @@ type columnCheck struct {
 	Value         columnCheckValue `yaml:"value"`
 	Blocking      *bool            `yaml:"blocking"`
 	Description   string           `yaml:"description,omitempty"`
+	Severity      string           `yaml:"severity"`
+	WarnIf        string           `yaml:"warn_if"`
+	ErrorIf       string           `yaml:"error_if"`
+	Scope         string           `yaml:"scope"`
 	Notifications Notifications    `yaml:"notifications"`
 }
 
@@ -307,6 +319,25 @@ type customCheck struct {
 	Value         int64         `yaml:"value"`
 	Count         *int64        `yaml:"count"`
 	Blocking      *bool         `yaml:"blocking"`
+	Severity      string        `yaml:"severity"`
+	WarnIf        string        `yaml:"warn_if"`
+	ErrorIf       string        `yaml:"error_if"`
+	Scope         string        `yaml:"scope"`
+	Notifications Notifications `yaml:"notifications"`
+}
+
+type tableCheck struct {
+	Name          string        `yaml:"name"`
+	Description   string        `yaml:"description"`
+	Min           *int64        `yaml:"min"`
+	Max           *int64        `yaml:"max"`
+	Column        string        `yaml:"column"`
+	MaxAge        string        `yaml:"max_age"`
+	MaxChange     *float64      `yaml:"max_change"`
+	Blocking      *bool         `yaml:"blocking"`
+	Severity      string        `yaml:"severity"`
+	WarnIf        string        `yaml:"warn_if"`
+	ErrorIf       string        `yaml:"error_if"`
 	Notifications Notifications `yaml:"notifications"`
 }
 
@@ -344,7 +375,9 @@ type taskDefinition struct {
 	Extends           []string          `yaml:"extends"`
 	Columns           []column          `yaml:"columns"`
 	CustomChecks      []customCheck     `yaml:"custom_checks"`
+	TableChecks       []tableCheck      `yaml:"table_checks"`
 	Hooks             Hooks             `yaml:"hooks"`
+	Tests             []UnitTest        `yaml:"tests"`
 	Tags              []string          `yaml:"tags"`
 	Snowflake         snowflake         `yaml:"snowflake"`
 	Athena            athena            `yaml:"athena"`
@@ -444,6 +477,10 @@ func ConvertYamlToTask(content []byte) (*Asset, error) {
 			seenTests[test.Name] = true
 
 			check := NewColumnCheck(definition.Name, column.Name, test.Name, ColumnCheckValue(test.Value), test.Blocking, test.Description)
+			check.Severity = CheckSeverity(strings.ToLower(strings.TrimSpace(test.Severity)))
+			check.WarnIf = strings.TrimSpace(test.WarnIf)
+			check.ErrorIf = strings.TrimSpace(test.ErrorIf)
+			check.Scope = CheckScope(strings.ToLower(strings.TrimSpace(test.Scope)))
 			check.Notifications = notificationsOrNil(test.Notifications)
 			tests = append(tests, check)
 		}
@@ -528,6 +565,7 @@ func ConvertYamlToTask(content []byte) (*Asset, error) {
 		Columns:           columns,
 		CustomChecks:      make([]CustomCheck, len(definition.CustomChecks)),
 		Hooks:             definition.Hooks,
+		Tests:             definition.Tests,
 		Snowflake:         SnowflakeConfig{Warehouse: definition.Snowflake.Warehouse},
 		Athena:            AthenaConfig{Location: definition.Athena.QueryResultsPath},
 		IntervalModifiers: definition.IntervalModifiers,
@@ -548,8 +586,31 @@ func ConvertYamlToTask(content []byte) (*Asset, error) {
 			Value:         check.Value,
 			Count:         check.Count,
 			Blocking:      DefaultTrueBool{Value: check.Blocking},
+			Severity:      CheckSeverity(strings.ToLower(strings.TrimSpace(check.Severity))),
+			WarnIf:        strings.TrimSpace(check.WarnIf),
+			ErrorIf:       strings.TrimSpace(check.ErrorIf),
+			Scope:         CheckScope(strings.ToLower(strings.TrimSpace(check.Scope))),
+			Notifications: notificationsOrNil(check.Notifications),
+		}
+	}
+
+	for _, check := range definition.TableChecks {
+		tc := TableCheck{
+			Name:          check.Name,
+			Description:   check.Description,
+			Min:           check.Min,
+			Max:           check.Max,
+			Column:        check.Column,
+			MaxAge:        check.MaxAge,
+			MaxChange:     check.MaxChange,
+			Blocking:      DefaultTrueBool{Value: check.Blocking},
+			Severity:      CheckSeverity(strings.ToLower(strings.TrimSpace(check.Severity))),
+			WarnIf:        strings.TrimSpace(check.WarnIf),
+			ErrorIf:       strings.TrimSpace(check.ErrorIf),
 			Notifications: notificationsOrNil(check.Notifications),
 		}
+		tc.ID = hash(fmt.Sprintf("%s-%s", task.Name, tc.HumanName()))
+		task.TableChecks = append(task.TableChecks, tc)
 	}
 
 	for index, m := range definition.Secrets {
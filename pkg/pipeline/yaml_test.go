@@ -8,6 +8,7 @@ import (
 	"github.com/bruin-data/bruin/pkg/path"
 	"github.com/bruin-data/bruin/pkg/pipeline"
 	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -422,3 +423,156 @@ hooks:
 		})
 	}
 }
+
+func TestConvertYamlToTask_TableChecks(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`name: dataset.orders
+type: duckdb.sql
+table_checks:
+  - name: row_count
+    min: 1
+    max: 1000
+  - name: freshness
+    column: updated_at
+    max_age: 6h
+    blocking: false
+  - name: row_count_change
+    max_change: 20.5
+`)
+
+	task, err := pipeline.ConvertYamlToTask(content)
+	require.NoError(t, err)
+	require.Len(t, task.TableChecks, 3)
+
+	rowCount := task.TableChecks[0]
+	assert.Equal(t, pipeline.TableCheckRowCount, rowCount.Name)
+	assert.Equal(t, int64(1), *rowCount.Min)
+	assert.Equal(t, int64(1000), *rowCount.Max)
+	assert.True(t, rowCount.Blocking.Bool())
+	assert.NotEmpty(t, rowCount.ID)
+
+	freshness := task.TableChecks[1]
+	assert.Equal(t, "updated_at", freshness.Column)
+	assert.Equal(t, "6h", freshness.MaxAge)
+	assert.False(t, freshness.Blocking.Bool())
+	assert.Equal(t, "freshness:updated_at", freshness.HumanName())
+
+	change := task.TableChecks[2]
+	assert.InDelta(t, 20.5, *change.MaxChange, 0.0001)
+	assert.NotEqual(t, rowCount.ID, change.ID)
+}
+
+func TestConvertYamlToTask_CheckSeverity(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`name: dataset.orders
+type: duckdb.sql
+columns:
+  - name: id
+    checks:
+      - name: not_null
+        severity: WARN
+      - name: unique
+        error_if: "> 10"
+        warn_if: ">0"
+custom_checks:
+  - name: few late orders
+    query: SELECT count(*) FROM dataset.orders WHERE late
+    value: 0
+    severity: warn
+`)
+
+	task, err := pipeline.ConvertYamlToTask(content)
+	require.NoError(t, err)
+	require.Len(t, task.Columns[0].Checks, 2)
+
+	notNull := task.Columns[0].Checks[0]
+	assert.Equal(t, pipeline.CheckSeverityWarn, notNull.Severity)
+	assert.Empty(t, notNull.WarnIf)
+	assert.Empty(t, notNull.ErrorIf)
+
+	unique := task.Columns[0].Checks[1]
+	assert.Empty(t, unique.Severity)
+	assert.Equal(t, ">0", unique.WarnIf)
+	assert.Equal(t, "> 10", unique.ErrorIf)
+
+	require.Len(t, task.CustomChecks, 1)
+	assert.Equal(t, pipeline.CheckSeverityWarn, task.CustomChecks[0].Severity)
+}
+
+func TestConvertYamlToTask_CheckScope(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`name: dataset.orders
+type: bq.sql
+materialization:
+  type: table
+  strategy: time_interval
+  incremental_key: dt
+  time_granularity: date
+columns:
+  - name: id
+    checks:
+      - name: unique
+        scope: Interval
+      - name: not_null
+custom_checks:
+  - name: no late orders
+    query: SELECT count(*) FROM dataset.orders WHERE late
+    scope: interval
+`)
+
+	task, err := pipeline.ConvertYamlToTask(content)
+	require.NoError(t, err)
+	require.Len(t, task.Columns[0].Checks, 2)
+
+	assert.Equal(t, pipeline.CheckScopeInterval, task.Columns[0].Checks[0].Scope)
+	assert.Empty(t, task.Columns[0].Checks[1].Scope)
+	assert.Equal(t, pipeline.CheckScopeInterval, task.CustomChecks[0].Scope)
+	assert.NoError(t, task.ValidateIntervalScope())
+}
+
+func TestConvertYamlToTask_UnitTests(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`name: analytics.totals
+type: bq.sql
+tests:
+  - name: sums the orders
+    given:
+      - table: raw.orders
+        rows:
+          - {id: 1, amount: 10.5, day: "2024-01-01"}
+          - {id: 2, amount: 20}
+      - table: raw.customers
+        csv: fixtures/customers.csv
+    expect:
+      rows:
+        - {total: 30.5}
+  - name: no orders
+    given:
+      - table: raw.orders
+        csv: fixtures/empty.csv
+`)
+
+	task, err := pipeline.ConvertYamlToTask(content)
+	require.NoError(t, err)
+	require.Len(t, task.Tests, 2)
+
+	first := task.Tests[0]
+	assert.Equal(t, "sums the orders", first.Name)
+	require.Len(t, first.Given, 2)
+	assert.Equal(t, "raw.orders", first.Given[0].Table)
+	assert.Equal(t, []map[string]any{
+		{"id": 1, "amount": 10.5, "day": "2024-01-01"},
+		{"id": 2, "amount": 20},
+	}, first.Given[0].Rows)
+	assert.Equal(t, "fixtures/customers.csv", first.Given[1].CSV)
+	assert.Equal(t, []map[string]any{{"total": 30.5}}, first.Expect.Rows)
+	require.NoError(t, first.Validate())
+
+	second := task.Tests[1]
+	assert.True(t, second.Expect.IsEmpty())
+	require.NoError(t, second.Validate())
+}
@@ -0,0 +1,254 @@
+package powerbi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultAPIURL       = "https://api.powerbi.com/v1.0/myorg"
+	defaultAuthURL      = "https://login.microsoftonline.com"
+	powerBIScope        = "https://analysis.windows.net/powerbi/api/.default"
+	defaultPollInterval = 10 * time.Second
+
+	// the number of refreshes looked through to find the one that was triggered, in case others started after it
+	refreshHistoryDepth = 10
+)
+
+// Refresh statuses as returned by the refresh history endpoint, "Unknown" means the refresh is still in progress.
+const (
+	RefreshStatusUnknown   = "Unknown"
+	RefreshStatusCompleted = "Completed"
+	RefreshStatusFailed    = "Failed"
+	RefreshStatusDisabled  = "Disabled"
+	RefreshStatusCancelled = "Cancelled"
+)
+
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	apiURL       string
+	authURL      string
+	pollInterval time.Duration
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+type Refresh struct {
+	RequestID            string `json:"requestId"`
+	RefreshType          string `json:"refreshType"`
+	Status               string `json:"status"`
+	StartTime            string `json:"startTime"`
+	EndTime              string `json:"endTime"`
+	ServiceExceptionJSON string `json:"serviceExceptionJson"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func NewClient(c Config) (*Client, error) {
+	if c.TenantID == "" {
+		return nil, errors.New("tenant_id is required for Power BI connection")
+	}
+	if c.ClientID == "" || c.ClientSecret == "" {
+		return nil, errors.New("client_id and client_secret are required for Power BI connection")
+	}
+
+	return &Client{
+		config: c,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		apiURL:       defaultAPIURL,
+		authURL:      defaultAuthURL,
+		pollInterval: defaultPollInterval,
+	}, nil
+}
+
+// token returns the access token of the service principal, requesting a new one a minute before the current expires.
+func (c *Client) token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accessToken != "" && time.Now().Before(c.tokenExpiry.Add(-time.Minute)) {
+		return c.accessToken, nil
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.config.ClientID},
+		"client_secret": {c.config.ClientSecret},
+		"scope":         {powerBIScope},
+	}
+
+	tokenURL := fmt.Sprintf("%s/%s/oauth2/v2.0/token", c.authURL, url.PathEscape(c.config.TenantID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create authentication request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to perform authentication request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", errors.Errorf("authentication failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var token tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", errors.Wrap(err, "failed to decode authentication response")
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("no access token received from Microsoft Entra ID")
+	}
+
+	c.accessToken = token.AccessToken
+	c.tokenExpiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return c.accessToken, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, payload, out any) (http.Header, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to authenticate with Power BI")
+	}
+
+	var body io.Reader
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request payload")
+		}
+		body = bytes.NewReader(payloadBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL+"/"+path, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create request for '%s'", path)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to perform request for '%s'", path)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, errors.Errorf("request for '%s' failed with status %d: %s", path, resp.StatusCode, string(respBody))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode the response for '%s'", path)
+		}
+	}
+
+	return resp.Header, nil
+}
+
+func datasetPath(workspaceID, datasetID string) string {
+	if workspaceID == "" {
+		return "datasets/" + url.PathEscape(datasetID)
+	}
+
+	return fmt.Sprintf("groups/%s/datasets/%s", url.PathEscape(workspaceID), url.PathEscape(datasetID))
+}
+
+// RefreshDataset starts a refresh of the semantic model and returns the ID of the request, the workspace ID is
+// optional for the datasets in "My workspace".
+func (c *Client) RefreshDataset(ctx context.Context, workspaceID, datasetID string) (string, error) {
+	payload := map[string]string{"notifyOption": "NoNotification"}
+
+	header, err := c.do(ctx, http.MethodPost, datasetPath(workspaceID, datasetID)+"/refreshes", payload, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return header.Get("RequestId"), nil
+}
+
+func (c *Client) ListRefreshes(ctx context.Context, workspaceID, datasetID string, top int) ([]Refresh, error) {
+	var resp struct {
+		Value []Refresh `json:"value"`
+	}
+
+	path := fmt.Sprintf("%s/refreshes?$top=%d", datasetPath(workspaceID, datasetID), top)
+	if _, err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+// WaitForRefresh polls the refresh history until the refresh with the given request ID finishes. Without a request ID
+// the latest refresh of the dataset is waited on.
+func (c *Client) WaitForRefresh(ctx context.Context, workspaceID, datasetID, requestID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		refreshes, err := c.ListRefreshes(ctx, workspaceID, datasetID, refreshHistoryDepth)
+		if err != nil {
+			if ctx.Err() != nil {
+				return errors.New("timed out waiting for Power BI refresh to complete")
+			}
+			return errors.Wrap(err, "failed to get the refresh history")
+		}
+
+		if refresh := findRefresh(refreshes, requestID); refresh != nil {
+			switch refresh.Status {
+			case RefreshStatusCompleted:
+				return nil
+			case RefreshStatusFailed, RefreshStatusDisabled, RefreshStatusCancelled:
+				return errors.Errorf("Power BI refresh finished with status '%s': %s", refresh.Status, refresh.ServiceExceptionJSON)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.New("timed out waiting for Power BI refresh to complete")
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+func findRefresh(refreshes []Refresh, requestID string) *Refresh {
+	if len(refreshes) == 0 {
+		return nil
+	}
+	if requestID == "" {
+		return &refreshes[0]
+	}
+
+	for i := range refreshes {
+		if refreshes[i].RequestID == requestID {
+			return &refreshes[i]
+		}
+	}
+
+	return nil
+}
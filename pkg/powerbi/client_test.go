@@ -0,0 +1,143 @@
+package powerbi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/tenant/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.FormValue("grant_type") != "client_credentials" ||
+			r.FormValue("client_id") != "id" || r.FormValue("client_secret") != "secret" || r.FormValue("scope") != powerBIScope {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token": "token123", "expires_in": 3600}`))
+	})
+	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(Config{TenantID: "tenant", ClientID: "id", ClientSecret: "secret"})
+	require.NoError(t, err)
+	client.apiURL = server.URL + "/api"
+	client.authURL = server.URL + "/auth"
+	client.pollInterval = time.Millisecond
+
+	return client
+}
+
+func TestNewClient_MissingRequiredFields(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(Config{ClientID: "id", ClientSecret: "secret"})
+	require.EqualError(t, err, "tenant_id is required for Power BI connection")
+
+	_, err = NewClient(Config{TenantID: "tenant", ClientSecret: "secret"})
+	require.EqualError(t, err, "client_id and client_secret are required for Power BI connection")
+}
+
+func TestClient_RefreshDataset(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("RequestId", "req-1")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	requestID, err := client.RefreshDataset(t.Context(), "ws", "ds")
+	require.NoError(t, err)
+	assert.Equal(t, "req-1", requestID)
+
+	_, err = client.RefreshDataset(t.Context(), "", "ds")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/api/groups/ws/datasets/ds/refreshes", "/api/datasets/ds/refreshes"}, paths)
+}
+
+func TestClient_WaitForRefresh(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		responses []string
+		requestID string
+		wantErr   string
+	}{
+		{
+			name: "completes after being in progress",
+			responses: []string{
+				`{"value": [{"requestId": "req-1", "status": "Unknown"}]}`,
+				`{"value": [{"requestId": "req-1", "status": "Completed"}]}`,
+			},
+			requestID: "req-1",
+		},
+		{
+			name: "waits until the triggered refresh is listed",
+			responses: []string{
+				`{"value": [{"requestId": "older", "status": "Completed"}]}`,
+				`{"value": [{"requestId": "newer", "status": "Unknown"}, {"requestId": "req-1", "status": "Failed", "serviceExceptionJson": "{\"errorCode\":\"ModelRefreshFailed\"}"}]}`,
+			},
+			requestID: "req-1",
+			wantErr:   `Power BI refresh finished with status 'Failed': {"errorCode":"ModelRefreshFailed"}`,
+		},
+		{
+			name: "the latest refresh is used without a request ID",
+			responses: []string{
+				`{"value": [{"requestId": "req-2", "status": "Cancelled"}]}`,
+			},
+			wantErr: "Power BI refresh finished with status 'Cancelled': ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/groups/ws/datasets/ds/refreshes", r.URL.Path)
+				assert.Equal(t, "10", r.URL.Query().Get("$top"))
+				_, _ = w.Write([]byte(tt.responses[min(calls, len(tt.responses)-1)]))
+				calls++
+			})
+
+			err := client.WaitForRefresh(t.Context(), "ws", "ds", tt.requestID, time.Minute)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.responses), calls)
+		})
+	}
+}
+
+func TestClient_WaitForRefresh_Timeout(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"value": [{"requestId": "req-1", "status": "Unknown"}]}`))
+	})
+
+	err := client.WaitForRefresh(t.Context(), "ws", "ds", "req-1", 20*time.Millisecond)
+	require.EqualError(t, err, "timed out waiting for Power BI refresh to complete")
+}
@@ -0,0 +1,12 @@
+package powerbi
+
+type Config struct {
+	Name         string `yaml:"name,omitempty" json:"name" mapstructure:"name"`
+	TenantID     string `yaml:"tenant_id,omitempty" json:"tenant_id" mapstructure:"tenant_id"`
+	ClientID     string `yaml:"client_id,omitempty" json:"client_id" mapstructure:"client_id"`
+	ClientSecret string `yaml:"client_secret,omitempty" json:"client_secret" mapstructure:"client_secret"`
+}
+
+func (c Config) GetName() string {
+	return c.Name
+}
@@ -0,0 +1,92 @@
+package powerbi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/bruin-data/bruin/pkg/executor"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/pkg/errors"
+)
+
+const defaultRefreshTimeout = 60 * time.Minute
+
+type BasicOperator struct {
+	connection config.ConnectionGetter
+}
+
+func NewBasicOperator(conn config.ConnectionGetter) *BasicOperator {
+	return &BasicOperator{
+		connection: conn,
+	}
+}
+
+func (o BasicOperator) Run(ctx context.Context, ti scheduler.TaskInstance) error {
+	return o.RunTask(ctx, ti.GetPipeline(), ti.GetAsset())
+}
+
+func (o BasicOperator) RunTask(ctx context.Context, p *pipeline.Pipeline, t *pipeline.Asset) error {
+	// without the refresh parameter the asset only marks the report in the lineage
+	if t.Parameters["refresh"] != "true" {
+		return nil
+	}
+
+	datasetID := t.Parameters["dataset_id"]
+	if datasetID == "" {
+		return errors.New("powerbi asset requires the 'dataset_id' parameter when 'refresh' is true")
+	}
+
+	connName, err := p.GetConnectionNameForAsset(t)
+	if err != nil {
+		return errors.Wrap(err, "failed to get connection name for asset")
+	}
+
+	rawConn := o.connection.GetConnection(connName)
+	if rawConn == nil {
+		return config.NewConnectionNotFoundError(ctx, "", connName)
+	}
+
+	client, ok := rawConn.(*Client)
+	if !ok {
+		return errors.Errorf("connection '%s' is not a powerbi connection", connName)
+	}
+
+	workspaceID := t.Parameters["workspace_id"]
+	requestID, err := client.RefreshDataset(ctx, workspaceID, datasetID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to start the refresh of Power BI dataset '%s'", datasetID)
+	}
+
+	fmt.Fprintf(writerFromContext(ctx), "Refresh of Power BI dataset '%s' started, waiting for it to complete.\n", datasetID)
+
+	if err := client.WaitForRefresh(ctx, workspaceID, datasetID, requestID, resolveRefreshTimeout(t.Parameters)); err != nil {
+		return errors.Wrapf(err, "failed to refresh Power BI dataset '%s'", datasetID)
+	}
+
+	return nil
+}
+
+func resolveRefreshTimeout(params map[string]string) time.Duration {
+	timeoutMinutes, err := strconv.Atoi(strings.TrimSpace(params["refresh_timeout_minutes"]))
+	if err != nil || timeoutMinutes <= 0 {
+		return defaultRefreshTimeout
+	}
+
+	return time.Duration(timeoutMinutes) * time.Minute
+}
+
+func writerFromContext(ctx context.Context) io.Writer {
+	if w := ctx.Value(executor.KeyPrinter); w != nil {
+		if wr, ok := w.(io.Writer); ok {
+			return wr
+		}
+	}
+	return os.Stdout
+}
@@ -0,0 +1,64 @@
+package powerbi
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bruin-data/bruin/pkg/executor"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type connections map[string]any
+
+func (c connections) GetConnection(name string) any {
+	return c[name]
+}
+
+func TestBasicOperator_RunTask(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost {
+			w.Header().Set("RequestId", "req-1")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		_, _ = w.Write([]byte(`{"value": [{"requestId": "req-1", "status": "Completed"}]}`))
+	})
+
+	op := NewBasicOperator(connections{"powerbi-default": client})
+	p := &pipeline.Pipeline{DefaultConnections: map[string]string{"powerbi": "powerbi-default"}}
+	out := &bytes.Buffer{}
+	ctx := context.WithValue(t.Context(), executor.KeyPrinter, out)
+
+	// the lineage-only assets need neither the parameters nor the connection
+	require.NoError(t, NewBasicOperator(connections{}).RunTask(ctx, p, &pipeline.Asset{Name: "report", Type: pipeline.AssetTypePowerBI}))
+
+	require.NoError(t, op.RunTask(ctx, p, &pipeline.Asset{
+		Name:       "sales",
+		Type:       pipeline.AssetTypePowerBI,
+		Parameters: map[string]string{"refresh": "true", "workspace_id": "ws", "dataset_id": "ds"},
+	}))
+	assert.Equal(t, []string{"POST /api/groups/ws/datasets/ds/refreshes", "GET /api/groups/ws/datasets/ds/refreshes"}, requests)
+	assert.Contains(t, out.String(), "Refresh of Power BI dataset 'ds' started")
+
+	err := op.RunTask(ctx, p, &pipeline.Asset{
+		Name:       "no_dataset",
+		Type:       pipeline.AssetTypePowerBI,
+		Parameters: map[string]string{"refresh": "true"},
+	})
+	require.EqualError(t, err, "powerbi asset requires the 'dataset_id' parameter when 'refresh' is true")
+
+	err = NewBasicOperator(connections{"powerbi-default": "not a client"}).RunTask(ctx, p, &pipeline.Asset{
+		Name:       "wrong_connection",
+		Type:       pipeline.AssetTypePowerBI,
+		Parameters: map[string]string{"refresh": "true", "dataset_id": "ds"},
+	})
+	require.EqualError(t, err, "connection 'powerbi-default' is not a powerbi connection")
+}
@@ -0,0 +1,74 @@
+package query
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bruin-data/bruin/pkg/config"
+	"github.com/pkg/errors"
+)
+
+type schemaSelector interface {
+	SelectWithSchema(ctx context.Context, queryObj *Query) (*QueryResult, error)
+}
+
+type introspectionResult struct {
+	once    sync.Once
+	columns []string
+	rows    [][]any
+	err     error
+}
+
+// Introspector runs the queries of the run_query and get_column_values Jinja functions on the connections of the
+// assets. The results are cached, so a query rendered for several assets runs only once; it is meant to live for a
+// single run.
+type Introspector struct {
+	connections config.ConnectionGetter
+
+	mu    sync.Mutex
+	cache map[string]*introspectionResult
+}
+
+func NewIntrospector(connections config.ConnectionGetter) *Introspector {
+	return &Introspector{
+		connections: connections,
+		cache:       make(map[string]*introspectionResult),
+	}
+}
+
+func (i *Introspector) Query(ctx context.Context, connName, sql string) ([]string, [][]any, error) {
+	key := connName + "\x00" + sql
+
+	i.mu.Lock()
+	result, ok := i.cache[key]
+	if !ok {
+		result = &introspectionResult{}
+		i.cache[key] = result
+	}
+	i.mu.Unlock()
+
+	result.once.Do(func() {
+		result.columns, result.rows, result.err = i.query(ctx, connName, sql)
+	})
+
+	return result.columns, result.rows, result.err
+}
+
+func (i *Introspector) query(ctx context.Context, connName, sql string) ([]string, [][]any, error) {
+	conn := i.connections.GetConnection(connName)
+	if conn == nil {
+		return nil, nil, config.NewConnectionNotFoundError(ctx, "", connName)
+	}
+
+	selector, ok := conn.(schemaSelector)
+	if !ok {
+		return nil, nil, errors.Errorf("connection '%s' does not support running queries while rendering", connName)
+	}
+
+	result, err := selector.SelectWithSchema(ctx, &Query{Query: sql})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Columns, result.Rows, nil
+}
@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockConnectionGetter struct {
+	connections map[string]any
+}
+
+func (m mockConnectionGetter) GetConnection(name string) any {
+	return m.connections[name]
+}
+
+type countingSelector struct {
+	calls  int
+	result *QueryResult
+	err    error
+}
+
+func (c *countingSelector) SelectWithSchema(ctx context.Context, queryObj *Query) (*QueryResult, error) {
+	c.calls++
+	return c.result, c.err
+}
+
+func TestIntrospector_Query(t *testing.T) {
+	t.Parallel()
+
+	selector := &countingSelector{result: &QueryResult{Columns: []string{"method"}, Rows: [][]any{{"card"}, {"cash"}}}}
+	failing := &countingSelector{err: errors.New("permission denied")}
+	introspector := NewIntrospector(mockConnectionGetter{connections: map[string]any{
+		"warehouse": selector,
+		"failing":   failing,
+		"api":       struct{}{},
+	}})
+
+	for range 3 {
+		columns, rows, err := introspector.Query(t.Context(), "warehouse", "SELECT DISTINCT method FROM raw.payments")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"method"}, columns)
+		assert.Equal(t, [][]any{{"card"}, {"cash"}}, rows)
+	}
+	assert.Equal(t, 1, selector.calls, "the result must be cached for the run")
+
+	_, _, err := introspector.Query(t.Context(), "warehouse", "SELECT 1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, selector.calls)
+
+	for range 2 {
+		_, _, err = introspector.Query(t.Context(), "failing", "SELECT 1")
+		require.EqualError(t, err, "permission denied")
+	}
+	assert.Equal(t, 1, failing.calls)
+
+	_, _, err = introspector.Query(t.Context(), "api", "SELECT 1")
+	require.EqualError(t, err, "connection 'api' does not support running queries while rendering")
+
+	_, _, err = introspector.Query(t.Context(), "missing", "SELECT 1")
+	require.Error(t, err)
+}
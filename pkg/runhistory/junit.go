@@ -0,0 +1,130 @@
+package runhistory
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/scheduler"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Skipped   int              `xml:"skipped,attr"`
+	Time      string           `xml:"time,attr"`
+	Timestamp string           `xml:"timestamp,attr,omitempty"`
+	Cases     []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+
+	duration Duration
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// JUnitReport renders the run as a JUnit XML report, with a test suite per asset and a test case per task, so that
+// CI systems can annotate the failing assets and checks.
+func (r *Run) JUnitReport() ([]byte, error) {
+	report := &junitTestSuites{
+		Name: r.Pipeline,
+		Time: formatJUnitDuration(r.Duration),
+	}
+
+	suites := make(map[string]*junitTestSuite)
+	for _, task := range r.Tasks {
+		suite, ok := suites[task.Asset]
+		if !ok {
+			suite = &junitTestSuite{Name: task.Asset}
+			suites[task.Asset] = suite
+			report.Suites = append(report.Suites, suite)
+		}
+
+		testCase := &junitTestCase{
+			Name:      task.ID,
+			Classname: r.Pipeline + "." + task.Asset,
+			Time:      formatJUnitDuration(task.Duration),
+			duration:  task.Duration,
+		}
+		if task.Check != nil {
+			testCase.SystemOut = task.Check.Query
+		}
+		if task.StartedAt != nil {
+			// RFC 3339 timestamps in UTC sort lexicographically, the suite starts with its earliest task
+			if startedAt := task.StartedAt.UTC().Format(time.RFC3339); suite.Timestamp == "" || startedAt < suite.Timestamp {
+				suite.Timestamp = startedAt
+			}
+		}
+
+		switch task.Status {
+		case scheduler.Succeeded.String():
+		case scheduler.Failed.String():
+			testCase.Failure = &junitFailure{Message: firstLine(task.Error), Type: task.Type, Text: task.Error}
+			suite.Failures++
+		case scheduler.UpstreamFailed.String():
+			testCase.Skipped = &junitSkipped{Message: "an upstream task failed"}
+			suite.Skipped++
+		default:
+			testCase.Skipped = &junitSkipped{Message: fmt.Sprintf("the task did not finish, its status is '%s'", task.Status)}
+			suite.Skipped++
+		}
+
+		suite.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	for _, suite := range report.Suites {
+		var total Duration
+		for _, testCase := range suite.Cases {
+			total += testCase.duration
+		}
+		suite.Time = formatJUnitDuration(total)
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+	}
+
+	out, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
+
+func formatJUnitDuration(d Duration) string {
+	return fmt.Sprintf("%.3f", time.Duration(d).Seconds())
+}
+
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
@@ -0,0 +1,61 @@
+package runhistory
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_JUnitReport(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	run := &Run{
+		Pipeline: "daily",
+		Duration: Duration(4 * time.Second),
+		Tasks: []*Task{
+			{ID: "orders", Asset: "orders", Type: "main", Status: "succeeded", StartedAt: &startedAt, Duration: Duration(1500 * time.Millisecond)},
+			{
+				ID: "orders:id:not_null", Asset: "orders", Type: "column_test", Status: "failed", Duration: Duration(time.Second),
+				Error: "check failed\nfound 3 null values",
+				Check: &Check{Name: "not_null", Column: "id", Query: "SELECT count(*) FROM orders WHERE id IS NULL"},
+			},
+			{ID: "revenue", Asset: "revenue", Type: "main", Status: "upstream_failed"},
+		},
+	}
+
+	out, err := run.JUnitReport()
+	require.NoError(t, err)
+
+	var report junitTestSuites
+	require.NoError(t, xml.Unmarshal(out, &report))
+
+	assert.Equal(t, "daily", report.Name)
+	assert.Equal(t, 3, report.Tests)
+	assert.Equal(t, 1, report.Failures)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, "4.000", report.Time)
+	require.Len(t, report.Suites, 2)
+
+	orders := report.Suites[0]
+	assert.Equal(t, "orders", orders.Name)
+	assert.Equal(t, "2.500", orders.Time)
+	assert.Equal(t, "2024-01-01T10:00:00Z", orders.Timestamp)
+	require.Len(t, orders.Cases, 2)
+	assert.Nil(t, orders.Cases[0].Failure)
+
+	failure := orders.Cases[1].Failure
+	require.NotNil(t, failure)
+	assert.Equal(t, "check failed", failure.Message)
+	assert.Equal(t, "column_test", failure.Type)
+	assert.Equal(t, "check failed\nfound 3 null values", failure.Text)
+	assert.Equal(t, "SELECT count(*) FROM orders WHERE id IS NULL", orders.Cases[1].SystemOut)
+
+	revenue := report.Suites[1]
+	require.Len(t, revenue.Cases, 1)
+	require.NotNil(t, revenue.Cases[0].Skipped)
+	assert.Equal(t, "an upstream task failed", revenue.Cases[0].Skipped.Message)
+}
@@ -0,0 +1,426 @@
+// Package runhistory keeps a local record of every pipeline run, so that questions like "when did this asset last
+// succeed and how long did it take" can be answered without a remote service.
+//
+// The history is an append-only JSON Lines file: every run is a single line, which keeps writes cheap and allows
+// multiple runs to record their results to the same file.
+package runhistory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+const (
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+
+	// maxLineSize caps the size of a single run record, runs with thousands of tasks and long errors are still well
+	// below this.
+	maxLineSize = 64 * 1024 * 1024
+)
+
+var ErrRunNotFound = errors.New("run not found")
+
+// Run is the record of a single `bruin run` invocation.
+type Run struct {
+	RunID        string              `json:"run_id"`
+	Pipeline     string              `json:"pipeline"`
+	PipelinePath string              `json:"pipeline_path"`
+	Status       string              `json:"status"`
+	StartedAt    time.Time           `json:"started_at"`
+	FinishedAt   time.Time           `json:"finished_at"`
+	Duration     Duration            `json:"duration"`
+	Cmdline      []string            `json:"cmdline"`
+	Parameters   scheduler.RunConfig `json:"parameters"`
+	LogPath      string              `json:"log_path,omitempty"`
+	Tasks        []*Task             `json:"tasks"`
+}
+
+// Task is the outcome of a single task instance in a run: an asset, one of its checks or its metadata push.
+type Task struct {
+	ID         string     `json:"id"`
+	Asset      string     `json:"asset"`
+	Type       string     `json:"type"`
+	Status     string     `json:"status"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Duration   Duration   `json:"duration,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	Check      *Check     `json:"check,omitempty"`
+}
+
+// Check describes the quality check a task ran, it is only set for column, custom and table checks.
+type Check struct {
+	Name        string `json:"name"`
+	Column      string `json:"column,omitempty"`
+	Description string `json:"description,omitempty"`
+	Value       any    `json:"value,omitempty"`
+	Count       *int64 `json:"count,omitempty"`
+	Blocking    bool   `json:"blocking"`
+	Query       string `json:"query,omitempty"`
+	// Observed is the value a table check measured, e.g. the row count of the table.
+	Observed *int64 `json:"observed,omitempty"`
+	// Warning is the failure of a check with the "warn" severity, such checks succeed but report it.
+	Warning string `json:"warning,omitempty"`
+}
+
+// Duration is a time.Duration that is stored in seconds, to keep the history readable for other tools.
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).Seconds())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+
+	*d = Duration(seconds * float64(time.Second))
+	return nil
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).Truncate(time.Millisecond).String()
+}
+
+// NewRun builds the record of a finished run from the final state of the scheduler's task instances and the
+// results of the ones that were executed. The instances that were skipped are not part of the run, e.g. the ones
+// excluded by a filter or the ones that already succeeded in a run that is continued.
+func NewRun(p *pipeline.Pipeline, runID string, instances []scheduler.TaskInstance, results []*scheduler.TaskExecutionResult, startedAt, finishedAt time.Time) *Run {
+	resultsByInstance := make(map[scheduler.TaskInstance]*scheduler.TaskExecutionResult, len(results))
+	for _, result := range results {
+		resultsByInstance[result.Instance] = result
+	}
+
+	run := &Run{
+		RunID:        runID,
+		Pipeline:     p.Name,
+		PipelinePath: filepath.Dir(p.DefinitionFile.Path),
+		Status:       StatusSucceeded,
+		StartedAt:    startedAt,
+		FinishedAt:   finishedAt,
+		Duration:     Duration(finishedAt.Sub(startedAt)),
+		Tasks:        make([]*Task, 0, len(instances)),
+	}
+
+	for _, instance := range instances {
+		if instance.GetStatus() == scheduler.Skipped {
+			continue
+		}
+
+		task := &Task{
+			ID:     instance.GetHumanID(),
+			Asset:  instance.GetAsset().Name,
+			Type:   instance.GetType().String(),
+			Status: instance.GetStatus().String(),
+			Check:  newCheck(instance),
+		}
+
+		if result, ok := resultsByInstance[instance]; ok {
+			if !result.StartedAt.IsZero() {
+				task.StartedAt = &result.StartedAt
+				task.FinishedAt = &result.FinishedAt
+				task.Duration = Duration(result.FinishedAt.Sub(result.StartedAt))
+			}
+			if result.Error != nil {
+				task.Error = result.Error.Error()
+			}
+		}
+
+		if status := instance.GetStatus(); status == scheduler.Failed || status == scheduler.UpstreamFailed {
+			run.Status = StatusFailed
+		}
+
+		run.Tasks = append(run.Tasks, task)
+	}
+
+	return run
+}
+
+func newCheck(instance scheduler.TaskInstance) *Check {
+	switch instance := instance.(type) {
+	case *scheduler.ColumnCheckInstance:
+		check := &Check{
+			Name:        instance.Check.Name,
+			Column:      instance.Column.Name,
+			Description: instance.Check.Description,
+			Blocking:    instance.Check.Blocking.Bool(),
+			Query:       instance.ExecutedQuery,
+		}
+		if instance.Check.Value.ToString() != "" {
+			check.Value = &instance.Check.Value
+		}
+		if instance.Warning != nil {
+			check.Warning = instance.Warning.Error()
+		}
+
+		return check
+	case *scheduler.CustomCheckInstance:
+		query := instance.ExecutedQuery
+		if query == "" {
+			query = instance.Check.Query
+		}
+
+		check := &Check{
+			Name:        instance.Check.Name,
+			Description: instance.Check.Description,
+			Value:       instance.Check.Value,
+			Count:       instance.Check.Count,
+			Blocking:    instance.Check.Blocking.Bool(),
+			Query:       query,
+		}
+		if instance.Warning != nil {
+			check.Warning = instance.Warning.Error()
+		}
+
+		return check
+	case *scheduler.TableCheckInstance:
+		check := &Check{
+			Name:        instance.Check.Name,
+			Column:      instance.Check.Column,
+			Description: instance.Check.Description,
+			Blocking:    instance.Check.Blocking.Bool(),
+			Query:       instance.ExecutedQuery,
+			Observed:    instance.Observed,
+		}
+		if instance.Warning != nil {
+			check.Warning = instance.Warning.Error()
+		}
+
+		return check
+	}
+
+	return nil
+}
+
+// MainTask returns the task that executed the asset itself, or nil if the asset was not part of the run.
+func (r *Run) MainTask(asset string) *Task {
+	for _, task := range r.Tasks {
+		if task.Asset == asset && task.Type == scheduler.TaskInstanceTypeMain.String() {
+			return task
+		}
+	}
+
+	return nil
+}
+
+// Filter narrows down the runs returned by Store.List. Empty fields match everything.
+type Filter struct {
+	Pipeline string
+	// Asset only keeps the runs that executed the given asset. When combined with Status, the status is matched
+	// against the asset's own task instead of the whole run.
+	Asset  string
+	Status string
+	Limit  int
+}
+
+func (f Filter) matches(run *Run) bool {
+	if f.Pipeline != "" && run.Pipeline != f.Pipeline {
+		return false
+	}
+
+	if f.Asset == "" {
+		return f.Status == "" || run.Status == f.Status
+	}
+
+	task := run.MainTask(f.Asset)
+	if task == nil {
+		return false
+	}
+
+	return f.Status == "" || task.Status == f.Status
+}
+
+type Store struct {
+	fs   afero.Fs
+	path string
+
+	// the history is append-only, so the runs read once are kept and only the lines appended since are parsed on the
+	// next read. offset is the size of the file the cache covers, a file shorter than that was rewritten and is read
+	// again from scratch.
+	mu       sync.Mutex
+	runs     []*Run
+	observed map[string]int64
+	offset   int64
+	lines    int
+}
+
+func NewStore(fs afero.Fs, path string) *Store {
+	return &Store{fs: fs, path: path}
+}
+
+// Record appends the given run to the history.
+func (s *Store) Record(run *Run) error {
+	line, err := json.Marshal(run)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize the run")
+	}
+
+	if err := s.fs.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return errors.Wrap(err, "failed to create the run history directory")
+	}
+
+	file, err := s.fs.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open the run history")
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return errors.Wrap(err, "failed to write to the run history")
+	}
+
+	return nil
+}
+
+// List returns the runs that match the filter, the most recent one first.
+func (s *Store) List(filter Filter) ([]*Run, error) {
+	runs, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]*Run, 0)
+	for _, run := range slices.Backward(runs) {
+		if !filter.matches(run) {
+			continue
+		}
+
+		matching = append(matching, run)
+		if filter.Limit > 0 && len(matching) == filter.Limit {
+			break
+		}
+	}
+
+	return matching, nil
+}
+
+// Get returns the run with the given ID.
+func (s *Store) Get(runID string) (*Run, error) {
+	runs, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, run := range slices.Backward(runs) {
+		if run.RunID == runID {
+			return run, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrRunNotFound, "no run with the ID '%s'", runID)
+}
+
+// LastObservedValue returns the value the task with the given ID observed in the most recent run that recorded one,
+// whether the check passed or not, it is how table checks like row_count_change compare against the previous run.
+func (s *Store) LastObservedValue(taskID string) (int64, bool, error) {
+	if _, err := s.readAll(); err != nil {
+		return 0, false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.observed[taskID]
+
+	return value, ok, nil
+}
+
+// readAll returns every run in the history, oldest first. The returned slice is shared with the cache and must not be
+// modified.
+func (s *Store) readAll() ([]*Run, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := s.fs.Stat(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.reset()
+			return []*Run{}, nil
+		}
+		return nil, errors.Wrap(err, "failed to open the run history")
+	}
+
+	if s.observed == nil || info.Size() < s.offset {
+		s.reset()
+	}
+	if info.Size() == s.offset {
+		return s.runs, nil
+	}
+
+	file, err := s.fs.Open(s.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the run history")
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(s.offset, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "failed to read the run history")
+	}
+
+	reader := bufio.NewReaderSize(file, 64*1024)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, errors.Wrap(err, "failed to read the run history")
+		}
+		if len(line) == 0 {
+			break
+		}
+		if len(line) > maxLineSize {
+			return nil, fmt.Errorf("line %d of the run history at '%s' is longer than %d bytes", s.lines+1, s.path, maxLineSize)
+		}
+
+		// a line without a newline at the end of the file may still be written by another run, it is parsed once
+		// it is complete
+		complete := err == nil
+		if content := bytes.TrimSpace(line); len(content) > 0 {
+			run := &Run{}
+			if unmarshalErr := json.Unmarshal(content, run); unmarshalErr != nil {
+				if !complete {
+					break
+				}
+				return nil, fmt.Errorf("failed to parse line %d of the run history at '%s': %w", s.lines+1, s.path, unmarshalErr)
+			}
+			s.add(run)
+		}
+		s.lines++
+		s.offset += int64(len(line))
+
+		if !complete {
+			break
+		}
+	}
+
+	return s.runs, nil
+}
+
+func (s *Store) reset() {
+	s.runs = make([]*Run, 0)
+	s.observed = make(map[string]int64)
+	s.offset = 0
+	s.lines = 0
+}
+
+func (s *Store) add(run *Run) {
+	s.runs = append(s.runs, run)
+	for _, task := range run.Tasks {
+		if task.Check != nil && task.Check.Observed != nil {
+			s.observed[task.ID] = *task.Check.Observed
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package runhistory
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/scheduler"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestRun(t *testing.T, runID string, startedAt time.Time, failOrders bool) *Run {
+	t.Helper()
+
+	orders := &pipeline.Asset{Name: "orders", Columns: []pipeline.Column{{Name: "id", Checks: []pipeline.ColumnCheck{{Name: "not_null"}}}}}
+	revenue := &pipeline.Asset{Name: "revenue", Upstreams: []pipeline.Upstream{{Type: "asset", Value: "orders"}}}
+	p := &pipeline.Pipeline{
+		Name:           "daily",
+		DefinitionFile: pipeline.DefinitionFile{Path: "/repo/daily/pipeline.yml"},
+		Assets:         []*pipeline.Asset{orders, revenue},
+	}
+
+	s := scheduler.NewScheduler(zap.NewNop().Sugar(), p, runID)
+	results := make([]*scheduler.TaskExecutionResult, 0)
+	for _, instance := range s.GetTaskInstances() {
+		if instance.GetAsset().Name != "orders" || instance.GetType() != scheduler.TaskInstanceTypeMain {
+			continue
+		}
+
+		result := &scheduler.TaskExecutionResult{Instance: instance, StartedAt: startedAt, FinishedAt: startedAt.Add(3 * time.Second)}
+		if failOrders {
+			result.Error = errors.New("table not found")
+			s.MarkTaskInstance(instance, scheduler.Failed, false)
+			for _, downstream := range instance.GetDownstream() {
+				s.MarkTaskInstance(downstream, scheduler.UpstreamFailed, true)
+			}
+		} else {
+			s.MarkTaskInstance(instance, scheduler.Succeeded, false)
+		}
+		results = append(results, result)
+	}
+
+	return NewRun(p, runID, s.GetTaskInstances(), results, startedAt, startedAt.Add(5*time.Second))
+}
+
+func TestNewRun(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	run := newTestRun(t, "run-1", startedAt, true)
+
+	assert.Equal(t, "daily", run.Pipeline)
+	assert.Equal(t, "/repo/daily", run.PipelinePath)
+	assert.Equal(t, StatusFailed, run.Status)
+	assert.Equal(t, Duration(5*time.Second), run.Duration)
+	require.Len(t, run.Tasks, 3)
+
+	orders := run.MainTask("orders")
+	require.NotNil(t, orders)
+	assert.Equal(t, "failed", orders.Status)
+	assert.Equal(t, "table not found", orders.Error)
+	assert.Equal(t, Duration(3*time.Second), orders.Duration)
+	assert.Equal(t, startedAt, *orders.StartedAt)
+
+	revenue := run.MainTask("revenue")
+	require.NotNil(t, revenue)
+	assert.Equal(t, "upstream_failed", revenue.Status)
+	assert.Nil(t, revenue.StartedAt)
+
+	assert.Nil(t, run.MainTask("customers"))
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(afero.NewMemMapFs(), "/repo/logs/runs/history.jsonl")
+
+	runs, err := store.List(Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, store.Record(newTestRun(t, "run-1", startedAt, false)))
+	require.NoError(t, store.Record(newTestRun(t, "run-2", startedAt.Add(time.Hour), true)))
+
+	runs, err = store.List(Filter{})
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "run-2", runs[0].RunID)
+	assert.Equal(t, "run-1", runs[1].RunID)
+
+	runs, err = store.List(Filter{Asset: "orders", Status: StatusSucceeded, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "run-1", runs[0].RunID)
+	assert.Equal(t, Duration(3*time.Second), runs[0].MainTask("orders").Duration)
+
+	runs, err = store.List(Filter{Status: StatusFailed})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "run-2", runs[0].RunID)
+
+	runs, err = store.List(Filter{Pipeline: "weekly"})
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+
+	run, err := store.Get("run-1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusSucceeded, run.Status)
+	assert.Equal(t, startedAt, run.StartedAt)
+
+	_, err = store.Get("missing")
+	require.ErrorIs(t, err, ErrRunNotFound)
+}
+
+func TestStore_LastObservedValue(t *testing.T) {
+	t.Parallel()
+
+	const taskID = "orders:table-check:row_count_change"
+	observed := func(runID, status string, value int64) *Run {
+		return &Run{
+			RunID: runID,
+			Tasks: []*Task{
+				{ID: "orders", Asset: "orders", Type: "main", Status: "succeeded"},
+				{ID: taskID, Asset: "orders", Type: "table_test", Status: status, Check: &Check{Name: "row_count_change", Observed: &value}},
+			},
+		}
+	}
+
+	store := NewStore(afero.NewMemMapFs(), "/repo/logs/runs/history.jsonl")
+
+	_, found, err := store.LastObservedValue(taskID)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.Record(observed("run-1", "succeeded", 100)))
+	require.NoError(t, store.Record(observed("run-2", "succeeded", 120)))
+	require.NoError(t, store.Record(observed("run-3", "failed", 10)))
+
+	// the failed run is the baseline of the next one, otherwise a real change in volume would fail every later run
+	value, found, err := store.LastObservedValue(taskID)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(10), value)
+
+	require.NoError(t, store.Record(observed("run-4", "succeeded", 11)))
+	value, found, err = store.LastObservedValue(taskID)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(11), value)
+
+	// the runs in which the query itself failed observed nothing and are skipped
+	require.NoError(t, store.Record(&Run{RunID: "run-5", Tasks: []*Task{{ID: taskID, Status: "failed", Check: &Check{Name: "row_count_change"}}}}))
+	value, _, err = store.LastObservedValue(taskID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), value)
+
+	_, found, err = store.LastObservedValue("customers:table-check:row_count_change")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestStore_ReadsOnlyTheAppendedRuns(t *testing.T) {
+	t.Parallel()
+
+	const path = "/repo/logs/runs/history.jsonl"
+	fs := afero.NewMemMapFs()
+	store := NewStore(fs, path)
+
+	require.NoError(t, store.Record(&Run{RunID: "run-1"}))
+	runs, err := store.List(Filter{})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+
+	// another run appends to the same file, the cached run is kept and only the new line is parsed
+	require.NoError(t, NewStore(fs, path).Record(&Run{RunID: "run-2"}))
+	cached := runs[0]
+	runs, err = store.List(Filter{})
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "run-2", runs[0].RunID)
+	assert.Same(t, cached, runs[1])
+
+	// a line that is still being written is left for the next read
+	file, err := fs.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"run_id": "run-3"`)
+	require.NoError(t, err)
+	runs, err = store.List(Filter{})
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+
+	_, err = file.WriteString(`, "status": "succeeded"}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	runs, err = store.List(Filter{})
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	assert.Equal(t, "run-3", runs[0].RunID)
+
+	// a rewritten history, e.g. after removing old runs, is read again from scratch
+	require.NoError(t, afero.WriteFile(fs, path, []byte(`{"run_id": "run-4"}`+"\n"), 0o644))
+	runs, err = store.List(Filter{})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "run-4", runs[0].RunID)
+
+	require.NoError(t, fs.Remove(path))
+	runs, err = store.List(Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+}
@@ -75,6 +75,8 @@ func (s TaskInstanceType) String() string {
 		return "custom_test"
 	case TaskInstanceTypeMetadataPush:
 		return "metadata_push"
+	case TaskInstanceTypeTableCheck:
+		return "table_test"
 	}
 	return "unknown"
 }
@@ -94,6 +96,7 @@ const (
 	TaskInstanceTypeColumnCheck
 	TaskInstanceTypeCustomCheck
 	TaskInstanceTypeMetadataPush
+	TaskInstanceTypeTableCheck
 )
 
 type TaskInstance interface {
@@ -146,10 +149,21 @@ type RunConfig struct {
 	ApplyIntervalModifiers bool     `json:"applyIntervalModifiers"`
 	Annotations            string   `json:"annotations"`
 	UseGong                bool     `json:"useGong"`
+	FailingRowsSample      int      `json:"failingRowsSample"`
 }
 
 type PipelineAssetState struct {
-	Name   string `json:"name"`
+	Name   string               `json:"name"`
+	Status string               `json:"status"`
+	Hash   string               `json:"hash,omitempty"`
+	Tasks  []*PipelineTaskState `json:"tasks,omitempty"`
+}
+
+// PipelineTaskState is the status of a single task instance of an asset, i.e. the asset itself, one of its checks or
+// its metadata push. It allows `--continue` to rerun only the failed parts of an asset.
+type PipelineTaskState struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
 	Status string `json:"status"`
 }
 
@@ -233,6 +247,8 @@ type ColumnCheckInstance struct {
 	Column        *pipeline.Column
 	Check         *pipeline.ColumnCheck
 	ExecutedQuery string
+	// Warning is set when the check passed with a warning, i.e. it failed with the "warn" severity.
+	Warning error
 }
 
 func (t *ColumnCheckInstance) GetType() TaskInstanceType {
@@ -252,6 +268,8 @@ type CustomCheckInstance struct {
 
 	Check         *pipeline.CustomCheck
 	ExecutedQuery string
+	// Warning is set when the check passed with a warning, i.e. it failed with the "warn" severity.
+	Warning error
 }
 
 func (t *CustomCheckInstance) GetType() TaskInstanceType {
@@ -266,6 +284,30 @@ func (t *CustomCheckInstance) Blocking() bool {
 	return t.Check.Blocking.Bool()
 }
 
+type TableCheckInstance struct {
+	*AssetInstance
+
+	Check         *pipeline.TableCheck
+	ExecutedQuery string
+	// Observed is the value the check measured, e.g. the row count, it is kept in the run history so that checks
+	// comparing against the previous run can find it.
+	Observed *int64
+	// Warning is set when the check passed with a warning, i.e. it failed with the "warn" severity.
+	Warning error
+}
+
+func (t *TableCheckInstance) GetType() TaskInstanceType {
+	return TaskInstanceTypeTableCheck
+}
+
+func (t *TableCheckInstance) GetHumanReadableDescription() string {
+	return fmt.Sprintf("%s - Table Check '%s'", t.Asset.Name, t.Check.HumanName())
+}
+
+func (t *TableCheckInstance) Blocking() bool {
+	return t.Check.Blocking.Bool()
+}
+
 type MetadataPushInstance struct {
 	*AssetInstance
 }
@@ -285,6 +327,10 @@ func (t *MetadataPushInstance) Blocking() bool {
 type TaskExecutionResult struct {
 	Instance TaskInstance
 	Error    error
+
+	// StartedAt and FinishedAt are set by the executor, they are empty for results that were not executed.
+	StartedAt  time.Time
+	FinishedAt time.Time
 }
 
 type InstancesByType map[TaskInstanceType][]TaskInstance
@@ -325,6 +371,13 @@ type Scheduler struct {
 
 	runID          string
 	onStatusChange func(StatusChangeEvent)
+
+	// maxActiveSteps caps the number of task instances that are queued or running at the same time, 0 means unlimited.
+	maxActiveSteps int
+	// connectionLimits caps the number of in-flight task instances per connection name.
+	connectionLimits map[string]int
+	// assetConnections holds the limited connections each asset uses, keyed by asset name.
+	assetConnections map[string][]string
 }
 
 // SetOnStatusChange registers a callback that fires whenever a task instance status changes.
@@ -332,6 +385,50 @@ func (s *Scheduler) SetOnStatusChange(fn func(StatusChangeEvent)) {
 	s.onStatusChange = fn
 }
 
+// SetMaxActiveSteps limits how many task instances can be in flight at once, regardless of the number of workers.
+// A value of 0 or less removes the limit.
+func (s *Scheduler) SetMaxActiveSteps(limit int) {
+	if limit < 0 {
+		limit = 0
+	}
+	s.maxActiveSteps = limit
+}
+
+// SetConnectionConcurrencyLimits limits how many task instances can use the same connection at once.
+// The limits are keyed by connection name, a task instance counts against every connection its asset uses.
+func (s *Scheduler) SetConnectionConcurrencyLimits(limits map[string]int) {
+	s.connectionLimits = make(map[string]int)
+	for name, limit := range limits {
+		if limit > 0 {
+			s.connectionLimits[name] = limit
+		}
+	}
+
+	s.assetConnections = make(map[string][]string)
+	if len(s.connectionLimits) == 0 {
+		return
+	}
+
+	for _, asset := range s.pipeline.Assets {
+		connNames, err := s.pipeline.GetAllConnectionNamesForAsset(asset)
+		if err != nil {
+			s.logger.Debugf("could not resolve the connections for asset '%s', skipping concurrency limits: %v", asset.Name, err)
+			continue
+		}
+
+		limited := make([]string, 0, len(connNames))
+		for _, connName := range connNames {
+			if _, ok := s.connectionLimits[connName]; ok && !slices.Contains(limited, connName) {
+				limited = append(limited, connName)
+			}
+		}
+
+		if len(limited) > 0 {
+			s.assetConnections[asset.Name] = limited
+		}
+	}
+}
+
 // GetTaskInstances returns all task instances for read-only access (e.g. TUI initialization).
 func (s *Scheduler) GetTaskInstances() []TaskInstance {
 	return s.taskInstances
@@ -415,6 +512,14 @@ func (s *Scheduler) MarkCheckInstancesByID(checkID string, asset *pipeline.Asset
 				return nil
 			}
 		}
+
+		tableCheck, ok := instance.(*TableCheckInstance)
+		if ok {
+			if tableCheck.Check.ID == checkID && tableCheck.Asset.ID == asset.ID {
+				s.MarkTaskInstance(instance, status, false)
+				return nil
+			}
+		}
 	}
 	return errors.New("cannot find check with the given ID")
 }
@@ -586,6 +691,22 @@ func NewScheduler(logger logger.Logger, p *pipeline.Pipeline, runID string) *Sch
 			instances = append(instances, testInstance)
 		}
 
+		for _, c := range task.TableChecks {
+			testInstance := &TableCheckInstance{
+				AssetInstance: &AssetInstance{
+					ID:         uuid.New().String(),
+					HumanID:    fmt.Sprintf("%s:table-check:%s", task.Name, c.HumanName()),
+					Pipeline:   p,
+					Asset:      task,
+					status:     Pending,
+					upstream:   make([]TaskInstance, 0),
+					downstream: make([]TaskInstance, 0),
+				},
+				Check: &c,
+			}
+			instances = append(instances, testInstance)
+		}
+
 		if p.MetadataPush.HasAnyEnabled() {
 			instances = append(instances, &MetadataPushInstance{
 				AssetInstance: &AssetInstance{
@@ -612,6 +733,9 @@ func NewScheduler(logger logger.Logger, p *pipeline.Pipeline, runID string) *Sch
 		Results:          make(chan *TaskExecutionResult),
 		runID:            runID,
 	}
+	if p.MaxActiveSteps != nil {
+		s.SetMaxActiveSteps(*p.MaxActiveSteps)
+	}
 	s.initialize()
 
 	return s
@@ -675,6 +799,7 @@ func (s *Scheduler) constructInstanceRelationships() {
 		// add the upstream-downstream relationships for the main task to its quality checks
 		s.taskNameMap[assetName].AddUpstreamByType(TaskInstanceTypeColumnCheck, ti)
 		s.taskNameMap[assetName].AddUpstreamByType(TaskInstanceTypeCustomCheck, ti)
+		s.taskNameMap[assetName].AddUpstreamByType(TaskInstanceTypeTableCheck, ti)
 		s.taskNameMap[assetName].AddUpstreamByType(TaskInstanceTypeMetadataPush, ti)
 
 		for _, dep := range ti.GetAsset().Upstreams {
@@ -750,7 +875,7 @@ func (s *Scheduler) Tick(result *TaskExecutionResult) bool {
 		return true
 	}
 
-	tasks := s.getScheduleableTasks()
+	tasks := s.applyConcurrencyLimits(s.getScheduleableTasks())
 	if len(tasks) == 0 {
 		return false
 	}
@@ -796,6 +921,58 @@ func (s *Scheduler) getScheduleableTasks() []TaskInstance {
 	return tasks
 }
 
+// applyConcurrencyLimits drops the tasks that would exceed max_active_steps or a connection's concurrency limit.
+// The dropped tasks stay pending and are picked up by a later tick once a slot frees up.
+func (s *Scheduler) applyConcurrencyLimits(tasks []TaskInstance) []TaskInstance {
+	if s.maxActiveSteps <= 0 && len(s.assetConnections) == 0 {
+		return tasks
+	}
+
+	inFlight := 0
+	connectionUsage := make(map[string]int)
+	for _, task := range s.taskInstances {
+		status := task.GetStatus()
+		if status != Queued && status != Running {
+			continue
+		}
+
+		inFlight++
+		for _, connName := range s.assetConnections[task.GetAsset().Name] {
+			connectionUsage[connName]++
+		}
+	}
+
+	selected := make([]TaskInstance, 0, len(tasks))
+	for _, task := range tasks {
+		if s.maxActiveSteps > 0 && inFlight >= s.maxActiveSteps {
+			break
+		}
+
+		connNames := s.assetConnections[task.GetAsset().Name]
+		if !s.hasConnectionCapacity(connNames, connectionUsage) {
+			continue
+		}
+
+		for _, connName := range connNames {
+			connectionUsage[connName]++
+		}
+		inFlight++
+		selected = append(selected, task)
+	}
+
+	return selected
+}
+
+func (s *Scheduler) hasConnectionCapacity(connNames []string, usage map[string]int) bool {
+	for _, connName := range connNames {
+		if usage[connName] >= s.connectionLimits[connName] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (s *Scheduler) allDependenciesSucceededForTask(t TaskInstance) bool {
 	if len(t.GetUpstream()) == 0 {
 		return true
@@ -823,8 +1000,19 @@ func (s *Scheduler) hasPipelineFinished() bool {
 
 func (s *Scheduler) SavePipelineState(fs afero.Fs, cmd []string, param *RunConfig, runID, statePath string) error {
 	dict := make(map[string][]TaskInstanceStatus)
+	tasks := make(map[string][]*PipelineTaskState)
+	hashes := make(map[string]string)
 	for _, task := range s.taskInstances {
-		dict[task.GetAsset().Name] = append(dict[task.GetAsset().Name], task.GetStatus())
+		assetName := task.GetAsset().Name
+		if _, ok := hashes[assetName]; !ok {
+			hashes[assetName] = task.GetAsset().GetCompatibilityHash()
+		}
+		dict[assetName] = append(dict[assetName], task.GetStatus())
+		tasks[assetName] = append(tasks[assetName], &PipelineTaskState{
+			ID:     task.GetHumanID(),
+			Type:   task.GetType().String(),
+			Status: task.GetStatus().String(),
+		})
 	}
 
 	state := make([]*PipelineAssetState, 0, len(dict))
@@ -833,6 +1021,8 @@ func (s *Scheduler) SavePipelineState(fs afero.Fs, cmd []string, param *RunConfi
 		state = append(state, &PipelineAssetState{
 			Name:   key,
 			Status: result.String(),
+			Hash:   hashes[key],
+			Tasks:  tasks[key],
 		})
 	}
 
@@ -858,31 +1048,82 @@ func (s *Scheduler) SavePipelineState(fs afero.Fs, cmd []string, param *RunConfi
 }
 
 func (s *Scheduler) RestoreState(state *PipelineState) error {
-	if s.pipeline.GetCompatibilityHash() != state.CompatibilityHash {
-		return errors.New("the pipeline has changed since the last run; please rerun the pipeline")
+	changedAssets, err := s.findChangedAssets(state)
+	if err != nil {
+		return err
 	}
+
 	stateMap := make(map[string]string)
+	taskStateMap := make(map[string]string)
 	for _, state := range state.State {
 		stateMap[state.Name] = state.Status
+		for _, taskState := range state.Tasks {
+			taskStateMap[taskState.ID] = taskState.Status
+		}
 	}
 
 	for _, task := range s.taskInstances {
-		taskName := task.GetAsset().Name
-		if status, exists := stateMap[taskName]; exists {
-			switch status {
-			case Failed.String(), UpstreamFailed.String(), Running.String(), Queued.String():
-				task.MarkAs(Pending)
-			case Skipped.String(), Succeeded.String():
-				task.MarkAs(Skipped)
-			default:
-				return fmt.Errorf("unknown status: %s. Please report this issue at https://github.com/bruin-data/bruin/issues/new", status)
-			}
+		if changedAssets[task.GetAsset().Name] {
+			continue
+		}
+
+		// states written by older versions only have the status of the asset as a whole
+		status, exists := taskStateMap[task.GetHumanID()]
+		if !exists {
+			status, exists = stateMap[task.GetAsset().Name]
+		}
+		if !exists {
+			continue
+		}
+
+		switch status {
+		case Failed.String(), UpstreamFailed.String(), Running.String(), Queued.String():
+			task.MarkAs(Pending)
+		case Skipped.String(), Succeeded.String():
+			task.MarkAs(Skipped)
+		default:
+			return fmt.Errorf("unknown status: %s. Please report this issue at https://github.com/bruin-data/bruin/issues/new", status)
+		}
+	}
+
+	// the assets that changed since the last run, and everything downstream of them, have to run again
+	for _, task := range s.taskInstances {
+		if changedAssets[task.GetAsset().Name] {
+			s.MarkTaskInstance(task, Pending, true)
 		}
 	}
 
 	return nil
 }
 
+// findChangedAssets diffs the assets of the saved state against the current pipeline and returns the names of the
+// assets that are new or whose definition changed since then.
+func (s *Scheduler) findChangedAssets(state *PipelineState) (map[string]bool, error) {
+	savedHashes := make(map[string]string, len(state.State))
+	for _, assetState := range state.State {
+		if assetState.Hash == "" {
+			// states written by older versions do not have the asset hashes, only the pipeline structure can be compared
+			if s.pipeline.GetCompatibilityHash() != state.CompatibilityHash {
+				return nil, errors.New("the pipeline has changed since the last run; please rerun the pipeline")
+			}
+
+			return map[string]bool{}, nil
+		}
+
+		savedHashes[assetState.Name] = assetState.Hash
+	}
+
+	changedAssets := make(map[string]bool)
+	for _, asset := range s.pipeline.Assets {
+		if savedHashes[asset.Name] != asset.GetCompatibilityHash() {
+			changedAssets[asset.Name] = true
+			s.logger.Debugf("asset '%s' changed since the last run, it will run again along with its downstream", asset.Name)
+		}
+	}
+
+	return changedAssets, nil
+}
+
 func GetStatusForTask(tasks []TaskInstanceStatus) TaskInstanceStatus {
 	dict := make(map[TaskInstanceStatus]bool)
 	for _, status := range tasks {
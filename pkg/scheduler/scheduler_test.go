@@ -901,6 +901,201 @@ func TestScheduler_RestoreState(t *testing.T) {
 	assert.Equal(t, expectedState.Cmdline, pipelineState.Cmdline, "Cmdline should match")
 }
 
+func TestScheduler_RestoreStateRerunsOnlyFailedChecks(t *testing.T) {
+	t.Parallel()
+
+	newPipeline := func() *pipeline.Pipeline {
+		return &pipeline.Pipeline{
+			Name: "test",
+			Assets: []*pipeline.Asset{
+				{
+					Name: "orders",
+					Type: "bq.sql",
+					Columns: []pipeline.Column{
+						{
+							Name: "status",
+							Checks: []pipeline.ColumnCheck{
+								{Name: "accepted_values"},
+								{Name: "not_null"},
+							},
+						},
+					},
+					CustomChecks: []pipeline.CustomCheck{{Name: "row count"}},
+				},
+				{
+					Name:      "report",
+					Type:      "bq.sql",
+					Upstreams: []pipeline.Upstream{{Type: "asset", Value: "orders"}},
+				},
+			},
+		}
+	}
+
+	statuses := map[string]TaskInstanceStatus{
+		"orders":                        Succeeded,
+		"orders:status:accepted_values": Failed,
+		"orders:status:not_null":        Succeeded,
+		"orders:custom-check:row_count": Succeeded,
+		"report":                        UpstreamFailed,
+	}
+
+	fs := afero.NewMemMapFs()
+	s := NewScheduler(zap.NewNop().Sugar(), newPipeline(), "run-1")
+	for _, instance := range s.GetTaskInstances() {
+		instance.MarkAs(statuses[instance.GetHumanID()])
+	}
+	require.NoError(t, s.SavePipelineState(fs, []string{"bruin", "run"}, &RunConfig{}, "run-1", "logs/runs"))
+
+	state, err := ReadState(fs, "logs/runs")
+	require.NoError(t, err)
+
+	restored := NewScheduler(zap.NewNop().Sugar(), newPipeline(), "run-2")
+	require.NoError(t, restored.RestoreState(state))
+
+	got := make(map[string]TaskInstanceStatus)
+	for _, instance := range restored.GetTaskInstances() {
+		got[instance.GetHumanID()] = instance.GetStatus()
+	}
+
+	assert.Equal(t, map[string]TaskInstanceStatus{
+		"orders":                        Skipped,
+		"orders:status:accepted_values": Pending,
+		"orders:status:not_null":        Skipped,
+		"orders:custom-check:row_count": Skipped,
+		"report":                        Pending,
+	}, got)
+
+	// states saved before the per-task statuses were introduced fall back to the status of the asset
+	for _, assetState := range state.State {
+		assetState.Tasks = nil
+	}
+
+	legacy := NewScheduler(zap.NewNop().Sugar(), newPipeline(), "run-3")
+	require.NoError(t, legacy.RestoreState(state))
+	assert.Equal(t, len(legacy.GetTaskInstances()), legacy.InstanceCountByStatus(Pending))
+}
+
+func TestScheduler_RestoreStateWithChangedPipeline(t *testing.T) {
+	t.Parallel()
+
+	newPipeline := func() *pipeline.Pipeline {
+		return &pipeline.Pipeline{
+			Name: "test",
+			Assets: []*pipeline.Asset{
+				{Name: "raw", Type: "bq.sql", ExecutableFile: pipeline.ExecutableFile{Content: "select 1"}},
+				{
+					Name:           "staging",
+					Type:           "bq.sql",
+					ExecutableFile: pipeline.ExecutableFile{Content: "select * from raw"},
+					Upstreams:      []pipeline.Upstream{{Type: "asset", Value: "raw"}},
+				},
+				{
+					Name:           "report",
+					Type:           "bq.sql",
+					ExecutableFile: pipeline.ExecutableFile{Content: "select * from staging"},
+					Upstreams:      []pipeline.Upstream{{Type: "asset", Value: "staging"}},
+				},
+				{Name: "other", Type: "bq.sql", ExecutableFile: pipeline.ExecutableFile{Content: "select 2"}},
+			},
+		}
+	}
+
+	statuses := map[string]TaskInstanceStatus{
+		"raw":     Succeeded,
+		"staging": Succeeded,
+		"report":  Failed,
+		"other":   Succeeded,
+	}
+
+	fs := afero.NewMemMapFs()
+	s := NewScheduler(zap.NewNop().Sugar(), newPipeline(), "run-1")
+	for _, instance := range s.GetTaskInstances() {
+		instance.MarkAs(statuses[instance.GetHumanID()])
+	}
+	require.NoError(t, s.SavePipelineState(fs, []string{"bruin", "run"}, &RunConfig{}, "run-1", "logs/runs"))
+
+	state, err := ReadState(fs, "logs/runs")
+	require.NoError(t, err)
+
+	getStatuses := func(s *Scheduler) map[string]TaskInstanceStatus {
+		got := make(map[string]TaskInstanceStatus)
+		for _, instance := range s.GetTaskInstances() {
+			got[instance.GetHumanID()] = instance.GetStatus()
+		}
+		return got
+	}
+
+	t.Run("edited leaf asset", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPipeline()
+		p.Assets[2].ExecutableFile.Content = "select * from staging where fixed"
+		p.Assets[2].Upstreams = append(p.Assets[2].Upstreams, pipeline.Upstream{Type: "asset", Value: "other"})
+
+		restored := NewScheduler(zap.NewNop().Sugar(), p, "run-2")
+		require.NoError(t, restored.RestoreState(state))
+		assert.Equal(t, map[string]TaskInstanceStatus{
+			"raw":     Skipped,
+			"staging": Skipped,
+			"report":  Pending,
+			"other":   Skipped,
+		}, getStatuses(restored))
+	})
+
+	t.Run("edited successful asset reruns its downstream", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPipeline()
+		p.Assets[1].ExecutableFile.Content = "select distinct * from raw"
+
+		restored := NewScheduler(zap.NewNop().Sugar(), p, "run-2")
+		require.NoError(t, restored.RestoreState(state))
+		assert.Equal(t, map[string]TaskInstanceStatus{
+			"raw":     Skipped,
+			"staging": Pending,
+			"report":  Pending,
+			"other":   Skipped,
+		}, getStatuses(restored))
+	})
+
+	t.Run("new asset", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPipeline()
+		p.Assets = append(p.Assets, &pipeline.Asset{
+			Name:      "new",
+			Type:      "bq.sql",
+			Upstreams: []pipeline.Upstream{{Type: "asset", Value: "other"}},
+		})
+
+		restored := NewScheduler(zap.NewNop().Sugar(), p, "run-2")
+		require.NoError(t, restored.RestoreState(state))
+		assert.Equal(t, map[string]TaskInstanceStatus{
+			"raw":     Skipped,
+			"staging": Skipped,
+			"report":  Pending,
+			"other":   Skipped,
+			"new":     Pending,
+		}, getStatuses(restored))
+	})
+
+	t.Run("state without asset hashes", func(t *testing.T) {
+		t.Parallel()
+
+		legacyState := *state
+		legacyState.State = make([]*PipelineAssetState, 0, len(state.State))
+		for _, assetState := range state.State {
+			legacyState.State = append(legacyState.State, &PipelineAssetState{Name: assetState.Name, Status: assetState.Status})
+		}
+
+		p := newPipeline()
+		p.Assets = append(p.Assets, &pipeline.Asset{Name: "new", Type: "bq.sql"})
+
+		restored := NewScheduler(zap.NewNop().Sugar(), p, "run-2")
+		require.EqualError(t, restored.RestoreState(&legacyState), "the pipeline has changed since the last run; please rerun the pipeline")
+	})
+}
+
 func TestScheduler_MarkAssetWithCustomChecks(t *testing.T) {
 	t.Parallel()
 
@@ -968,6 +1163,57 @@ func TestScheduler_MarkAssetWithCustomChecks(t *testing.T) {
 	}
 }
 
+func TestScheduler_TableChecks(t *testing.T) {
+	t.Parallel()
+
+	nonBlocking := false
+	asset := &pipeline.Asset{
+		Name: "shop.orders",
+		Type: pipeline.AssetTypeSnowflakeQuery,
+		TableChecks: []pipeline.TableCheck{
+			{ID: "row-count", Name: pipeline.TableCheckRowCount},
+			{ID: "freshness", Name: pipeline.TableCheckFreshness, Column: "updated_at", Blocking: pipeline.DefaultTrueBool{Value: &nonBlocking}},
+		},
+	}
+	downstream := &pipeline.Asset{
+		Name:      "shop.daily_orders",
+		Type:      pipeline.AssetTypeSnowflakeQuery,
+		Upstreams: []pipeline.Upstream{{Type: "asset", Value: "shop.orders"}},
+	}
+
+	p := &pipeline.Pipeline{
+		Name:   "TestPipeline",
+		Assets: []*pipeline.Asset{asset, downstream},
+	}
+
+	s := NewScheduler(zap.NewNop().Sugar(), p, "test")
+
+	var checks []*TableCheckInstance
+	for _, instance := range s.GetTaskInstances() {
+		if tc, ok := instance.(*TableCheckInstance); ok {
+			checks = append(checks, tc)
+		}
+	}
+	require.Len(t, checks, 2)
+	assert.Equal(t, "shop.orders:table-check:row_count", checks[0].GetHumanID())
+	assert.Equal(t, "shop.orders:table-check:freshness:updated_at", checks[1].GetHumanID())
+	assert.Equal(t, "table_test", checks[0].GetType().String())
+
+	// the checks run after the asset itself
+	assert.Equal(t, asset.Name, checks[0].GetUpstream()[0].GetAsset().Name)
+	assert.Equal(t, TaskInstanceTypeMain, checks[0].GetUpstream()[0].GetType())
+
+	// only the blocking check holds back the downstream asset
+	downstreamInstance := s.taskNameMap[downstream.Name][TaskInstanceTypeMain][0]
+	assert.Contains(t, downstreamInstance.GetUpstream(), TaskInstance(checks[0]))
+	assert.NotContains(t, downstreamInstance.GetUpstream(), TaskInstance(checks[1]))
+
+	s.MarkAll(Skipped)
+	require.NoError(t, s.MarkCheckInstancesByID("freshness", asset, Pending))
+	assert.Equal(t, Pending, checks[1].GetStatus())
+	assert.Equal(t, Skipped, checks[0].GetStatus())
+}
+
 func TestScheduler_RunDoesNotDeadlockWithManyInitiallyEligibleTasks(t *testing.T) {
 	t.Parallel()
 
@@ -1004,3 +1250,111 @@ func TestScheduler_RunDoesNotDeadlockWithManyInitiallyEligibleTasks(t *testing.T
 		t.Fatal("scheduler Run did not return after all tasks completed")
 	}
 }
+
+func TestScheduler_TickHonorsMaxActiveSteps(t *testing.T) {
+	t.Parallel()
+
+	maxActiveSteps := 2
+	p := &pipeline.Pipeline{
+		MaxActiveSteps: &maxActiveSteps,
+		Assets: []*pipeline.Asset{
+			{Name: "task1"},
+			{Name: "task2"},
+			{Name: "task3"},
+			{Name: "task4"},
+			{
+				Name: "task5",
+				Upstreams: []pipeline.Upstream{
+					{Type: "asset", Value: "task1"},
+				},
+			},
+		},
+	}
+
+	s := NewScheduler(zap.NewNop().Sugar(), p, "test")
+	s.Kickstart()
+
+	// only two tasks should be in flight even though four are eligible
+	require.Len(t, s.WorkQueue, 2)
+	first := <-s.WorkQueue
+	second := <-s.WorkQueue
+	assert.Equal(t, "task1", first.GetHumanID())
+	assert.Equal(t, "task2", second.GetHumanID())
+
+	// finishing one task frees up a single slot
+	finished := s.Tick(&TaskExecutionResult{Instance: first})
+	assert.False(t, finished)
+	require.Len(t, s.WorkQueue, 1)
+	third := <-s.WorkQueue
+	assert.Equal(t, "task3", third.GetHumanID())
+
+	finished = s.Tick(&TaskExecutionResult{Instance: second})
+	assert.False(t, finished)
+	require.Len(t, s.WorkQueue, 1)
+	fourth := <-s.WorkQueue
+	assert.Equal(t, "task4", fourth.GetHumanID())
+
+	finished = s.Tick(&TaskExecutionResult{Instance: third})
+	assert.False(t, finished)
+	require.Len(t, s.WorkQueue, 1)
+	fifth := <-s.WorkQueue
+	assert.Equal(t, "task5", fifth.GetHumanID())
+
+	assert.False(t, s.Tick(&TaskExecutionResult{Instance: fourth}))
+	assert.Empty(t, s.WorkQueue)
+	assert.True(t, s.Tick(&TaskExecutionResult{Instance: fifth}))
+}
+
+func TestScheduler_TickHonorsConnectionConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	p := &pipeline.Pipeline{
+		Assets: []*pipeline.Asset{
+			{Name: "duck1", Type: pipeline.AssetTypeDuckDBQuery, Connection: "duckdb-default"},
+			{Name: "duck2", Type: pipeline.AssetTypeDuckDBQuery, Connection: "duckdb-default"},
+			{Name: "bq1", Type: pipeline.AssetTypeBigqueryQuery, Connection: "gcp-default"},
+			{Name: "bq2", Type: pipeline.AssetTypeBigqueryQuery, Connection: "gcp-default"},
+			{
+				Name:       "duck3",
+				Type:       pipeline.AssetTypeDuckDBQuery,
+				Connection: "duckdb-default",
+				Columns: []pipeline.Column{
+					{
+						Name:   "id",
+						Checks: []pipeline.ColumnCheck{{Name: "not_null"}},
+					},
+				},
+			},
+		},
+	}
+
+	s := NewScheduler(zap.NewNop().Sugar(), p, "test")
+	s.SetConnectionConcurrencyLimits(map[string]int{
+		"duckdb-default": 1,
+		"unused":         0,
+	})
+	s.Kickstart()
+
+	// only a single duckdb asset is dispatched, bigquery is unlimited
+	require.Len(t, s.WorkQueue, 3)
+	duck1 := <-s.WorkQueue
+	assert.Equal(t, "duck1", duck1.GetHumanID())
+	assert.Equal(t, "bq1", (<-s.WorkQueue).GetHumanID())
+	assert.Equal(t, "bq2", (<-s.WorkQueue).GetHumanID())
+
+	assert.False(t, s.Tick(&TaskExecutionResult{Instance: duck1}))
+	require.Len(t, s.WorkQueue, 1)
+	duck2 := <-s.WorkQueue
+	assert.Equal(t, "duck2", duck2.GetHumanID())
+
+	assert.False(t, s.Tick(&TaskExecutionResult{Instance: duck2}))
+	require.Len(t, s.WorkQueue, 1)
+	duck3 := <-s.WorkQueue
+	assert.Equal(t, "duck3", duck3.GetHumanID())
+
+	// the check runs against the same connection, so it waits for the main task
+	assert.False(t, s.Tick(&TaskExecutionResult{Instance: duck3}))
+	require.Len(t, s.WorkQueue, 1)
+	check := <-s.WorkQueue
+	assert.Equal(t, "duck3:id:not_null", check.GetHumanID())
+}
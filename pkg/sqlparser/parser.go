@@ -397,6 +397,43 @@ func (s *SQLParser) AddLimit(sql string, limit int, dialect string) (string, err
 	return resp.Query, nil
 }
 
+// Transpile translates the query from one dialect to another, e.g. to run a BigQuery query on DuckDB.
+func (s *SQLParser) Transpile(sql, fromDialect, toDialect string) (string, error) {
+	err := s.Start()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to start sql parser")
+	}
+
+	command := parserCommand{
+		Command: "transpile",
+		Contents: map[string]interface{}{
+			"query": sql,
+			"read":  fromDialect,
+			"write": toDialect,
+		},
+	}
+
+	responsePayload, err := s.sendCommand(&command)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send command")
+	}
+
+	var resp struct {
+		Query string `json:"query"`
+		Error string `json:"error"`
+	}
+	err = json.Unmarshal([]byte(responsePayload), &resp)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal response")
+	}
+
+	if resp.Error != "" {
+		return "", errors.New(resp.Error)
+	}
+
+	return resp.Query, nil
+}
+
 func (s *SQLParser) IsSingleSelectQuery(sql string, dialect string) (bool, error) {
 	err := s.Start()
 	if err != nil {
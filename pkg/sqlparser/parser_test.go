@@ -1182,6 +1182,51 @@ func TestSqlParser_AddLimit(t *testing.T) { //nolint
 	}
 }
 
+func TestSqlParser_Transpile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       string
+		fromDialect string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "bigquery functions are translated",
+			query:       "SELECT SAFE_DIVIDE(amount, quantity) AS unit_price FROM `project.sales.orders`",
+			fromDialect: "bigquery",
+			want:        `SELECT CASE WHEN quantity <> 0 THEN amount / quantity ELSE NULL END AS unit_price FROM "project"."sales"."orders"`,
+		},
+		{
+			name:        "multiple statements are kept",
+			query:       "SELECT DATE_TRUNC(d, MONTH) FROM t; SELECT 1",
+			fromDialect: "bigquery",
+			want:        "SELECT DATE_TRUNC('MONTH', d) FROM t;\nSELECT 1",
+		},
+		{
+			name:        "invalid query",
+			query:       "SELECT * FROM",
+			fromDialect: "snowflake",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := sharedSQLParser.Transpile(tt.query, tt.fromDialect, "duckdb")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestGetMissingDependenciesForAsset(t *testing.T) {
 	tests := []struct {
 		name          string
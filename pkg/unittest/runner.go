@@ -0,0 +1,401 @@
+package unittest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	duck "github.com/bruin-data/bruin/pkg/duckdb"
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/bruin-data/bruin/pkg/sqlparser"
+	"github.com/pkg/errors"
+)
+
+const (
+	duckDBDialect      = "duckdb"
+	testDatabase       = "bruin_unit_test"
+	fixtureTablePrefix = "bruin_fixture_"
+	actualTable        = "bruin_actual"
+	expectedTable      = "bruin_expected"
+)
+
+var (
+	dateRegex      = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	timestampRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}[ T]\d{2}:\d{2}:\d{2}(\.\d+)?$`)
+)
+
+type Parser interface {
+	IsSingleSelectQuery(sql, dialect string) (bool, error)
+	UsedTables(sql, dialect string) ([]string, error)
+	RenameTables(sql, dialect string, tableMapping map[string]string) (string, error)
+	Transpile(sql, fromDialect, toDialect string) (string, error)
+}
+
+type DB interface {
+	RunQueryWithoutResult(ctx context.Context, q *query.Query) error
+	SelectWithSchema(ctx context.Context, q *query.Query) (*query.QueryResult, error)
+}
+
+// Result is the outcome of a single unit test, a test that could not be run carries the error instead of the rows.
+type Result struct {
+	Asset          string
+	Test           string
+	Columns        []string
+	MissingRows    [][]any
+	UnexpectedRows [][]any
+	Err            error
+}
+
+func (r *Result) Passed() bool {
+	return r.Err == nil && len(r.MissingRows) == 0 && len(r.UnexpectedRows) == 0
+}
+
+// Runner runs the unit tests of SQL assets on a DuckDB database that only lives for the duration of each test, the
+// queries written for other platforms are transpiled to DuckDB.
+type Runner struct {
+	parser Parser
+	newDB  func(path string) (DB, error)
+}
+
+func NewRunner(parser Parser) *Runner {
+	return &Runner{
+		parser: parser,
+		newDB: func(path string) (DB, error) {
+			client, err := duck.NewClient(duck.Config{Path: path})
+			if err != nil {
+				return nil, err
+			}
+			return client, nil
+		},
+	}
+}
+
+// RunAsset runs all the unit tests of the asset against its rendered query.
+func (r *Runner) RunAsset(ctx context.Context, asset *pipeline.Asset, renderedQuery string) []*Result {
+	results := make([]*Result, 0, len(asset.Tests))
+	for i := range asset.Tests {
+		results = append(results, r.Run(ctx, asset, renderedQuery, &asset.Tests[i]))
+	}
+
+	return results
+}
+
+func (r *Runner) Run(ctx context.Context, asset *pipeline.Asset, renderedQuery string, test *pipeline.UnitTest) *Result {
+	result := &Result{Asset: asset.Name, Test: test.Name}
+	if err := r.run(ctx, asset, renderedQuery, test, result); err != nil {
+		result.Err = err
+	}
+
+	return result
+}
+
+func (r *Runner) run(ctx context.Context, asset *pipeline.Asset, renderedQuery string, test *pipeline.UnitTest, result *Result) error {
+	if err := test.Validate(); err != nil {
+		return err
+	}
+
+	testQuery, err := r.prepareQuery(asset, renderedQuery, test)
+	if err != nil {
+		return err
+	}
+
+	dir, err := os.MkdirTemp("", "bruin-unit-test-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create the directory of the test database")
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := r.newDB(filepath.Join(dir, testDatabase+".duckdb"))
+	if err != nil {
+		return errors.Wrap(err, "failed to create the test database")
+	}
+
+	for i, fixture := range test.Given {
+		source, err := rowsQuery(asset, fixture.Rows, fixture.CSV)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fixture for table '%s'", fixture.Table)
+		}
+		if err := createTable(ctx, db, fixtureTableName(i), source); err != nil {
+			return errors.Wrapf(err, "failed to create the fixture for table '%s'", fixture.Table)
+		}
+	}
+
+	if err := createTable(ctx, db, actualTable, testQuery); err != nil {
+		return errors.Wrap(err, "failed to run the query of the asset on the fixtures")
+	}
+
+	actualColumns, err := tableColumns(ctx, db, actualTable)
+	if err != nil {
+		return err
+	}
+
+	if test.Expect.IsEmpty() {
+		unexpected, err := db.SelectWithSchema(ctx, &query.Query{Query: fmt.Sprintf("SELECT * FROM %s ORDER BY ALL", actualTable)})
+		if err != nil {
+			return errors.Wrap(err, "failed to read the output of the query")
+		}
+		result.Columns = actualColumns
+		result.UnexpectedRows = unexpected.Rows
+		return nil
+	}
+
+	source, err := rowsQuery(asset, test.Expect.Rows, test.Expect.CSV)
+	if err != nil {
+		return errors.Wrap(err, "invalid expected rows")
+	}
+	if err := createTable(ctx, db, expectedTable, source); err != nil {
+		return errors.Wrap(err, "failed to load the expected rows")
+	}
+
+	expectedColumns, err := tableColumns(ctx, db, expectedTable)
+	if err != nil {
+		return err
+	}
+
+	// only the columns that are given in the expectation are compared, the rest of the output is ignored
+	var missingColumns []string
+	for _, column := range expectedColumns {
+		if !slices.ContainsFunc(actualColumns, func(c string) bool { return strings.EqualFold(c, column) }) {
+			missingColumns = append(missingColumns, column)
+		}
+	}
+	if len(missingColumns) > 0 {
+		return errors.Errorf("the query does not return the expected columns: %s", strings.Join(missingColumns, ", "))
+	}
+
+	result.Columns = expectedColumns
+	result.MissingRows, err = exceptRows(ctx, db, expectedColumns, expectedTable, actualTable)
+	if err != nil {
+		return errors.Wrap(err, "failed to compare the output with the expected rows")
+	}
+	result.UnexpectedRows, err = exceptRows(ctx, db, expectedColumns, actualTable, expectedTable)
+	if err != nil {
+		return errors.Wrap(err, "failed to compare the output with the expected rows")
+	}
+
+	return nil
+}
+
+// prepareQuery points the query of the asset at the fixture tables and translates it to DuckDB.
+func (r *Runner) prepareQuery(asset *pipeline.Asset, renderedQuery string, test *pipeline.UnitTest) (string, error) {
+	dialect, err := sqlparser.AssetTypeToDialect(asset.Type)
+	if err != nil {
+		return "", errors.Wrap(err, "unit tests are not supported for the asset")
+	}
+
+	isSelect, err := r.parser.IsSingleSelectQuery(renderedQuery, dialect)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse the query of the asset")
+	}
+	if !isSelect {
+		return "", errors.New("unit tests require the query of the asset to be a single SELECT statement")
+	}
+
+	// the fixtures are referenced with all three parts, the parts of the original reference that are not replaced
+	// would otherwise be kept, e.g. the project of a BigQuery table
+	mapping := make(map[string]string, len(test.Given))
+	for i, fixture := range test.Given {
+		mapping[fixture.Table] = testDatabase + ".main." + fixtureTableName(i)
+	}
+
+	testQuery, err := r.parser.RenameTables(renderedQuery, dialect, mapping)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to replace the upstream tables with the fixtures")
+	}
+
+	// the tables without fixtures would only fail once the query runs, report them by their original names instead
+	usedTables, err := r.parser.UsedTables(testQuery, dialect)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to find the tables used by the query")
+	}
+	var missingFixtures []string
+	for _, table := range usedTables {
+		if !strings.HasPrefix(strings.ToLower(table), testDatabase+".main."+fixtureTablePrefix) {
+			missingFixtures = append(missingFixtures, table)
+		}
+	}
+	if len(missingFixtures) > 0 {
+		return "", errors.Errorf("the query reads tables that have no fixture in the test: %s", strings.Join(missingFixtures, ", "))
+	}
+
+	if dialect == duckDBDialect {
+		return testQuery, nil
+	}
+
+	testQuery, err = r.parser.Transpile(testQuery, dialect, duckDBDialect)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to transpile the query from %s to DuckDB", dialect)
+	}
+
+	return testQuery, nil
+}
+
+func fixtureTableName(index int) string {
+	return fixtureTablePrefix + strconv.Itoa(index)
+}
+
+func createTable(ctx context.Context, db DB, table, source string) error {
+	source = strings.TrimRight(strings.TrimSpace(source), ";")
+	return db.RunQueryWithoutResult(ctx, &query.Query{Query: fmt.Sprintf("CREATE TABLE %s AS %s", table, source)})
+}
+
+func tableColumns(ctx context.Context, db DB, table string) ([]string, error) {
+	result, err := db.SelectWithSchema(ctx, &query.Query{Query: fmt.Sprintf("SELECT * FROM %s LIMIT 0", table)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the columns of %s", table)
+	}
+
+	return result.Columns, nil
+}
+
+// exceptRows returns the rows of the first table that are not in the second one, duplicates are counted.
+func exceptRows(ctx context.Context, db DB, columns []string, from, except string) ([][]any, error) {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = quoteIdentifier(column)
+	}
+	selected := strings.Join(quoted, ", ")
+
+	q := fmt.Sprintf("SELECT %s FROM %s EXCEPT ALL SELECT %s FROM %s ORDER BY ALL", selected, from, selected, except)
+	result, err := db.SelectWithSchema(ctx, &query.Query{Query: q})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Rows, nil
+}
+
+// rowsQuery builds the query selecting the given rows, or the rows of the CSV file when there are none.
+func rowsQuery(asset *pipeline.Asset, rows []map[string]any, csv string) (string, error) {
+	if csv != "" {
+		path := asset.CSVPath(csv)
+		if _, err := os.Stat(path); err != nil {
+			return "", errors.Errorf("cannot read the csv file '%s'", path)
+		}
+
+		return fmt.Sprintf("SELECT * FROM read_csv(%s, header = true)", quoteString(path)), nil
+	}
+
+	return valuesQuery(rows)
+}
+
+func valuesQuery(rows []map[string]any) (string, error) {
+	var columns []string
+	for _, row := range rows {
+		for column := range row {
+			if !slices.Contains(columns, column) {
+				columns = append(columns, column)
+			}
+		}
+	}
+	slices.Sort(columns)
+
+	literals := make(map[string]func(any) (string, error), len(columns))
+	for _, column := range columns {
+		literals[column] = columnLiteral(rows, column)
+	}
+
+	values := make([]string, len(rows))
+	for i, row := range rows {
+		rowValues := make([]string, len(columns))
+		for j, column := range columns {
+			literal, err := literals[column](row[column])
+			if err != nil {
+				return "", errors.Wrapf(err, "invalid value for column '%s'", column)
+			}
+			rowValues[j] = literal
+		}
+		values[i] = "(" + strings.Join(rowValues, ", ") + ")"
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = quoteIdentifier(column)
+	}
+
+	return fmt.Sprintf("SELECT * FROM (VALUES %s) AS t(%s)", strings.Join(values, ", "), strings.Join(quotedColumns, ", ")), nil
+}
+
+// columnLiteral picks how the values of the column are written, strings are typed as dates or timestamps when all
+// the values of the column look like one so that the query can use them as such.
+func columnLiteral(rows []map[string]any, column string) func(any) (string, error) {
+	allMatch := func(re *regexp.Regexp) bool {
+		found := false
+		for _, row := range rows {
+			switch v := row[column].(type) {
+			case nil:
+				continue
+			case string:
+				if !re.MatchString(v) {
+					return false
+				}
+				found = true
+			default:
+				return false
+			}
+		}
+		return found
+	}
+
+	typed := func(typeName string) func(any) (string, error) {
+		return func(v any) (string, error) {
+			if v == nil {
+				return "NULL", nil
+			}
+			return typeName + " " + quoteString(v.(string)), nil
+		}
+	}
+
+	switch {
+	case allMatch(dateRegex):
+		return typed("DATE")
+	case allMatch(timestampRegex):
+		return typed("TIMESTAMP")
+	default:
+		return literal
+	}
+}
+
+func literal(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case string:
+		return quoteString(v), nil
+	case time.Time:
+		if v.Equal(v.Truncate(24*time.Hour)) && v.Location() == time.UTC {
+			return "DATE " + quoteString(v.Format(time.DateOnly)), nil
+		}
+		return "TIMESTAMP " + quoteString(v.UTC().Format("2006-01-02 15:04:05.999999")), nil
+	default:
+		return "", errors.Errorf("unsupported value '%v', fixture values must be strings, numbers, booleans or null", value)
+	}
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
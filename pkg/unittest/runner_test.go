@@ -0,0 +1,371 @@
+package unittest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bruin-data/bruin/pkg/pipeline"
+	"github.com/bruin-data/bruin/pkg/query"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockParser struct {
+	mock.Mock
+}
+
+func (m *mockParser) IsSingleSelectQuery(sql, dialect string) (bool, error) {
+	args := m.Called(sql, dialect)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockParser) UsedTables(sql, dialect string) ([]string, error) {
+	args := m.Called(sql, dialect)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockParser) RenameTables(sql, dialect string, tableMapping map[string]string) (string, error) {
+	args := m.Called(sql, dialect, tableMapping)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockParser) Transpile(sql, fromDialect, toDialect string) (string, error) {
+	args := m.Called(sql, fromDialect, toDialect)
+	return args.String(0), args.Error(1)
+}
+
+// fakeDB records the statements it runs and answers the selects from the given results, in order.
+type fakeDB struct {
+	statements []string
+	selects    []string
+	results    []*query.QueryResult
+	runErr     error
+}
+
+func (f *fakeDB) RunQueryWithoutResult(ctx context.Context, q *query.Query) error {
+	f.statements = append(f.statements, q.Query)
+	return f.runErr
+}
+
+func (f *fakeDB) SelectWithSchema(ctx context.Context, q *query.Query) (*query.QueryResult, error) {
+	f.selects = append(f.selects, q.Query)
+	if len(f.results) == 0 {
+		return nil, errors.New("unexpected select")
+	}
+
+	result := f.results[0]
+	f.results = f.results[1:]
+	return result, nil
+}
+
+func newTestRunner(parser Parser, db *fakeDB) *Runner {
+	return &Runner{
+		parser: parser,
+		newDB: func(path string) (DB, error) {
+			return db, nil
+		},
+	}
+}
+
+func ordersTest() pipeline.UnitTest {
+	return pipeline.UnitTest{
+		Name: "totals",
+		Given: []pipeline.UnitTestFixture{
+			{
+				Table: "raw.orders",
+				Rows: []map[string]any{
+					{"id": 1, "amount": 10.5},
+					{"id": 2, "amount": 20},
+				},
+			},
+		},
+		Expect: pipeline.UnitTestRows{
+			Rows: []map[string]any{
+				{"total": 30.5},
+			},
+		},
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	renderedQuery := "SELECT SUM(amount) AS total FROM raw.orders"
+	renamedQuery := "SELECT SUM(amount) AS total FROM bruin_unit_test.main.bruin_fixture_0"
+	mapping := map[string]string{"raw.orders": "bruin_unit_test.main.bruin_fixture_0"}
+
+	tests := []struct {
+		name                   string
+		assetType              pipeline.AssetType
+		test                   func() pipeline.UnitTest
+		setupParser            func(m *mockParser)
+		results                []*query.QueryResult
+		wantPassed             bool
+		wantErr                string
+		wantMissing            [][]any
+		wantUnexpected         [][]any
+		wantStatementsContains []string
+	}{
+		{
+			name:      "matching output passes",
+			assetType: pipeline.AssetTypeDuckDBQuery,
+			test:      ordersTest,
+			setupParser: func(m *mockParser) {
+				m.On("IsSingleSelectQuery", renderedQuery, "duckdb").Return(true, nil)
+				m.On("RenameTables", renderedQuery, "duckdb", mapping).Return(renamedQuery, nil)
+				m.On("UsedTables", renamedQuery, "duckdb").Return([]string{"bruin_unit_test.main.bruin_fixture_0"}, nil)
+			},
+			results: []*query.QueryResult{
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}},
+			},
+			wantPassed: true,
+			wantStatementsContains: []string{
+				`CREATE TABLE bruin_fixture_0 AS SELECT * FROM (VALUES (10.5, 1), (20, 2)) AS t("amount", "id")`,
+				"CREATE TABLE bruin_actual AS " + renamedQuery,
+				`CREATE TABLE bruin_expected AS SELECT * FROM (VALUES (30.5)) AS t("total")`,
+			},
+		},
+		{
+			name:      "different output fails with the missing and unexpected rows",
+			assetType: pipeline.AssetTypeDuckDBQuery,
+			test:      ordersTest,
+			setupParser: func(m *mockParser) {
+				m.On("IsSingleSelectQuery", renderedQuery, "duckdb").Return(true, nil)
+				m.On("RenameTables", renderedQuery, "duckdb", mapping).Return(renamedQuery, nil)
+				m.On("UsedTables", renamedQuery, "duckdb").Return([]string{"bruin_unit_test.main.bruin_fixture_0"}, nil)
+			},
+			results: []*query.QueryResult{
+				{Columns: []string{"TOTAL", "extra"}},
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}, Rows: [][]any{{30.5}}},
+				{Columns: []string{"total"}, Rows: [][]any{{30.0}}},
+			},
+			wantMissing:    [][]any{{30.5}},
+			wantUnexpected: [][]any{{30.0}},
+		},
+		{
+			name:      "queries of other platforms are transpiled to duckdb",
+			assetType: pipeline.AssetTypeBigqueryQuery,
+			test:      ordersTest,
+			setupParser: func(m *mockParser) {
+				m.On("IsSingleSelectQuery", renderedQuery, "bigquery").Return(true, nil)
+				m.On("RenameTables", renderedQuery, "bigquery", mapping).Return(renamedQuery, nil)
+				m.On("UsedTables", renamedQuery, "bigquery").Return([]string{"bruin_unit_test.main.bruin_fixture_0"}, nil)
+				m.On("Transpile", renamedQuery, "bigquery", "duckdb").Return("SELECT transpiled", nil)
+			},
+			results: []*query.QueryResult{
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}},
+			},
+			wantPassed:             true,
+			wantStatementsContains: []string{"CREATE TABLE bruin_actual AS SELECT transpiled"},
+		},
+		{
+			name:      "empty expectation fails with every returned row",
+			assetType: pipeline.AssetTypeDuckDBQuery,
+			test: func() pipeline.UnitTest {
+				test := ordersTest()
+				test.Expect = pipeline.UnitTestRows{}
+				return test
+			},
+			setupParser: func(m *mockParser) {
+				m.On("IsSingleSelectQuery", renderedQuery, "duckdb").Return(true, nil)
+				m.On("RenameTables", renderedQuery, "duckdb", mapping).Return(renamedQuery, nil)
+				m.On("UsedTables", renamedQuery, "duckdb").Return([]string{"bruin_unit_test.main.bruin_fixture_0"}, nil)
+			},
+			results: []*query.QueryResult{
+				{Columns: []string{"total"}},
+				{Columns: []string{"total"}, Rows: [][]any{{30.5}}},
+			},
+			wantUnexpected: [][]any{{30.5}},
+		},
+		{
+			name:      "tables without fixtures are reported",
+			assetType: pipeline.AssetTypeDuckDBQuery,
+			test:      ordersTest,
+			setupParser: func(m *mockParser) {
+				m.On("IsSingleSelectQuery", renderedQuery, "duckdb").Return(true, nil)
+				m.On("RenameTables", renderedQuery, "duckdb", mapping).Return(renamedQuery, nil)
+				m.On("UsedTables", renamedQuery, "duckdb").Return([]string{"bruin_unit_test.main.bruin_fixture_0", "raw.customers"}, nil)
+			},
+			wantErr: "the query reads tables that have no fixture in the test: raw.customers",
+		},
+		{
+			name:      "queries that are not a single select are rejected",
+			assetType: pipeline.AssetTypeDuckDBQuery,
+			test:      ordersTest,
+			setupParser: func(m *mockParser) {
+				m.On("IsSingleSelectQuery", renderedQuery, "duckdb").Return(false, nil)
+			},
+			wantErr: "unit tests require the query of the asset to be a single SELECT statement",
+		},
+		{
+			name:      "expected columns missing from the output are reported",
+			assetType: pipeline.AssetTypeDuckDBQuery,
+			test:      ordersTest,
+			setupParser: func(m *mockParser) {
+				m.On("IsSingleSelectQuery", renderedQuery, "duckdb").Return(true, nil)
+				m.On("RenameTables", renderedQuery, "duckdb", mapping).Return(renamedQuery, nil)
+				m.On("UsedTables", renamedQuery, "duckdb").Return([]string{"bruin_unit_test.main.bruin_fixture_0"}, nil)
+			},
+			results: []*query.QueryResult{
+				{Columns: []string{"sum"}},
+				{Columns: []string{"total"}},
+			},
+			wantErr: "the query does not return the expected columns: total",
+		},
+		{
+			name:      "invalid tests are not run",
+			assetType: pipeline.AssetTypeDuckDBQuery,
+			test: func() pipeline.UnitTest {
+				test := ordersTest()
+				test.Given = nil
+				return test
+			},
+			setupParser: func(m *mockParser) {},
+			wantErr:     "unit test 'totals' must give the rows of at least one upstream table",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parser := new(mockParser)
+			tt.setupParser(parser)
+			db := &fakeDB{results: tt.results}
+
+			asset := &pipeline.Asset{Name: "analytics.totals", Type: tt.assetType}
+			test := tt.test()
+			result := newTestRunner(parser, db).Run(context.Background(), asset, renderedQuery, &test)
+
+			assert.Equal(t, "analytics.totals", result.Asset)
+			assert.Equal(t, "totals", result.Test)
+			if tt.wantErr != "" {
+				require.Error(t, result.Err)
+				assert.Contains(t, result.Err.Error(), tt.wantErr)
+				assert.False(t, result.Passed())
+				return
+			}
+
+			require.NoError(t, result.Err)
+			assert.Equal(t, tt.wantPassed, result.Passed())
+			assert.Equal(t, tt.wantMissing, result.MissingRows)
+			assert.Equal(t, tt.wantUnexpected, result.UnexpectedRows)
+			for _, statement := range tt.wantStatementsContains {
+				assert.Contains(t, db.statements, statement)
+			}
+			parser.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunner_RunWithCSVFixture(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "orders.csv"), []byte("id,amount\n1,10\n"), 0o600))
+
+	asset := &pipeline.Asset{
+		Name:           "analytics.totals",
+		Type:           pipeline.AssetTypeDuckDBQuery,
+		DefinitionFile: pipeline.TaskDefinitionFile{Path: filepath.Join(dir, "totals.sql")},
+	}
+	test := pipeline.UnitTest{
+		Name: "from csv",
+		Given: []pipeline.UnitTestFixture{
+			{Table: "raw.orders", CSV: "orders.csv"},
+			{Table: "raw.refunds", CSV: "missing.csv"},
+		},
+	}
+
+	parser := new(mockParser)
+	parser.On("IsSingleSelectQuery", mock.Anything, "duckdb").Return(true, nil)
+	parser.On("RenameTables", mock.Anything, "duckdb", mock.Anything).Return("SELECT 1", nil)
+	parser.On("UsedTables", "SELECT 1", "duckdb").Return([]string{}, nil)
+	db := &fakeDB{}
+
+	result := newTestRunner(parser, db).Run(context.Background(), asset, "SELECT 1", &test)
+
+	require.Error(t, result.Err)
+	assert.Contains(t, result.Err.Error(), "invalid fixture for table 'raw.refunds'")
+	require.Len(t, db.statements, 1)
+	assert.Equal(t, "CREATE TABLE bruin_fixture_0 AS SELECT * FROM read_csv('"+filepath.Join(dir, "orders.csv")+"', header = true)", db.statements[0])
+}
+
+func TestValuesQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rows    []map[string]any
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "missing values are null",
+			rows: []map[string]any{
+				{"id": 1, "name": "it's"},
+				{"id": 2, "active": true},
+			},
+			want: `SELECT * FROM (VALUES (NULL, 1, 'it''s'), (TRUE, 2, NULL)) AS t("active", "id", "name")`,
+		},
+		{
+			name: "dates and timestamps are typed",
+			rows: []map[string]any{
+				{"day": "2024-01-01", "at": "2024-01-01 10:00:00"},
+				{"day": nil, "at": "2024-01-02T11:30:00.5"},
+			},
+			want: `SELECT * FROM (VALUES (TIMESTAMP '2024-01-01 10:00:00', DATE '2024-01-01'), (TIMESTAMP '2024-01-02T11:30:00.5', NULL)) AS t("at", "day")`,
+		},
+		{
+			name: "columns mixing dates with other values stay strings",
+			rows: []map[string]any{
+				{"day": "2024-01-01"},
+				{"day": "yesterday"},
+			},
+			want: `SELECT * FROM (VALUES ('2024-01-01'), ('yesterday')) AS t("day")`,
+		},
+		{
+			name: "parsed dates and timestamps",
+			rows: []map[string]any{
+				{"day": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), "at": time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+			},
+			want: `SELECT * FROM (VALUES (TIMESTAMP '2024-01-01 10:00:00', DATE '2024-01-01')) AS t("at", "day")`,
+		},
+		{
+			name: "nested values are not supported",
+			rows: []map[string]any{
+				{"tags": []any{"a", "b"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := valuesQuery(tt.rows)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.False(t, strings.HasSuffix(got, ";"))
+		})
+	}
+}
@@ -7,6 +7,7 @@ from parser.main import (
     get_tables,
     add_limit,
     is_single_select_query,
+    transpile,
 )
 
 from pathlib import Path
@@ -63,6 +64,10 @@ def main():
                 logging.info("got is-single-select command")
                 c = cmd["contents"]
                 result = is_single_select_query(c["query"], c["dialect"])
+            elif cmd["command"] == "transpile":
+                logging.info("got transpile command")
+                c = cmd["contents"]
+                result = transpile(c["query"], c["read"], c["write"])
             elif cmd["command"] == "exit":
                 logging.info("got exit command amx")
                 break
@@ -1,6 +1,6 @@
 import logging
 from dataclasses import dataclass
-from sqlglot import parse_one, parse, exp, lineage
+from sqlglot import parse_one, parse, exp, lineage, transpile as sqlglot_transpile
 from sqlglot.lineage import Node
 from sqlglot.optimizer import optimize
 from sqlglot.optimizer.scope import find_all_in_scope, build_scope
@@ -548,3 +548,19 @@ def is_single_select_query(query: str, dialect: str = None) -> dict:
 
     except Exception as e:
         return {"is_single_select": False, "error": str(e)}
+
+
+def transpile(query: str, read: str, write: str) -> dict:
+    """
+    Translate the statements in the query from the read dialect to the write dialect.
+    Returns {"query": str, "error": str}
+    """
+    try:
+        statements = sqlglot_transpile(query, read=read, write=write)
+    except Exception as e:
+        return {"query": "", "error": str(e)}
+
+    if not statements:
+        return {"query": "", "error": "cannot parse query"}
+
+    return {"query": ";\n".join(statements), "error": ""}
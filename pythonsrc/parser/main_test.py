@@ -9,6 +9,7 @@ from .main import (
     get_tables,
     add_limit,
     is_single_select_query,
+    transpile,
 )
 
 SCHEMA = {
@@ -2477,3 +2478,20 @@ def test_get_column_lineage_case_insensitive_schema():
     assert result["columns"][0]["upstream"] == [
         {"column": "name", "table": "raw.Teams"}
     ]
+
+
+def test_transpile():
+    query = "SELECT SAFE_DIVIDE(amount, quantity) AS unit_price FROM `project.sales.orders`"
+
+    result = transpile(query, "bigquery", "duckdb")
+    assert result["error"] == ""
+    assert (
+        result["query"]
+        == 'SELECT CASE WHEN quantity <> 0 THEN amount / quantity ELSE NULL END AS unit_price FROM "project"."sales"."orders"'
+    )
+
+
+def test_transpile_invalid_query():
+    result = transpile("SELECT * FROM", "bigquery", "duckdb")
+    assert result["query"] == ""
+    assert result["error"] != ""